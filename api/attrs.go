@@ -44,3 +44,29 @@ type MeasuredValue struct {
 	Number *float64 `json:"number"`
 	Unit   *string  `json:"unit"`
 }
+
+// AttrRequest representa un atributo enviado al crear o actualizar recursos
+// Solo incluye los campos que acepta la API al escribir
+type AttrRequest struct {
+	ID          string         `json:"id"`                     // ID del atributo (ej. BRAND)
+	ValueID     *string        `json:"value_id,omitempty"`     // ID del valor (para atributos tipo list)
+	ValueName   string         `json:"value_name,omitempty"`   // Valor en texto
+	ValueStruct *MeasuredValue `json:"value_struct,omitempty"` // Valor con unidad (number_unit)
+}
+
+// NewAttrRequests convierte atributos leídos de la API en atributos para escritura
+func NewAttrRequests(attrs []Attr) []AttrRequest {
+	if attrs == nil {
+		return nil
+	}
+	requests := make([]AttrRequest, 0, len(attrs))
+	for _, attr := range attrs {
+		requests = append(requests, AttrRequest{
+			ID:          attr.ID,
+			ValueID:     attr.ValueID,
+			ValueName:   attr.ValueName,
+			ValueStruct: attr.MeasuredValue,
+		})
+	}
+	return requests
+}
@@ -2,11 +2,12 @@ package api
 
 import (
 	"context"
+	"time"
 
 	"github.com/tidyrocks/mercado-libre-go-sdk/internal/http"
 )
 
-const tokenEndpoint = "https://api.mercadolibre.com/oauth/token"
+const tokenEndpoint = "/oauth/token"
 
 // Token representa un token de acceso de la API de Mercado Libre
 type Token struct {
@@ -16,6 +17,24 @@ type Token struct {
 	Scope        string `json:"scope"`         // Permisos ("offline_access read write")
 	UserID       int64  `json:"user_id"`       // ID del usuario
 	RefreshToken string `json:"refresh_token"` // Token para renovar
+	// Campos calculados por el SDK
+	Expiry time.Time `json:"expiry"` // Momento de expiración (calculado a partir de ExpiresIn al obtenerlo)
+}
+
+// expiresWithin indica si el token expira dentro de d. Un Expiry vacío se considera vigente.
+func (t Token) expiresWithin(d time.Duration) bool {
+	if t.Expiry.IsZero() {
+		return false
+	}
+	return time.Now().Add(d).After(t.Expiry)
+}
+
+// withExpiry fija Expiry a partir de ExpiresIn.
+func (t Token) withExpiry() Token {
+	if t.ExpiresIn > 0 {
+		t.Expiry = time.Now().Add(time.Duration(t.ExpiresIn) * time.Second)
+	}
+	return t
 }
 
 // refreshTokenRequest representa la solicitud de renovación de token (uso interno)
@@ -27,7 +46,7 @@ type refreshTokenRequest struct {
 }
 
 // RefreshAccessToken renueva un access token usando el refresh token
-func RefreshAccessToken(ctx context.Context, clientID, clientSecret, refreshToken string) (Token, error) {
+func (c *Client) RefreshAccessToken(ctx context.Context, clientID, clientSecret, refreshToken string) (Token, error) {
 	request := refreshTokenRequest{
 		GrantType:    "refresh_token",
 		ClientID:     clientID,
@@ -36,6 +55,11 @@ func RefreshAccessToken(ctx context.Context, clientID, clientSecret, refreshToke
 	}
 
 	var token Token
-	err := http.DoPostJSON(ctx, tokenEndpoint, "", request, &token)
-	return token, err
-}
\ No newline at end of file
+	err := http.DoPostJSON(anonymous(ctx), c, c.endpoint(tokenEndpoint), "", request, &token)
+	return token.withExpiry(), err
+}
+
+// RefreshAccessToken renueva un access token usando el Client por defecto
+func RefreshAccessToken(ctx context.Context, clientID, clientSecret, refreshToken string) (Token, error) {
+	return defaultClient.RefreshAccessToken(ctx, clientID, clientSecret, refreshToken)
+}
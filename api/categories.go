@@ -2,13 +2,12 @@ package api
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/tidyrocks/mercado-libre-go-sdk/internal/http"
 )
 
-const categoriesEndpoint = "https://api.mercadolibre.com/categories"
-const siteCategoriesEndpoint = "https://api.mercadolibre.com/sites"
+const categoriesEndpoint = "/categories"
+const siteCategoriesEndpoint = "/sites"
 
 // Category representa una categoría de productos en Mercado Libre
 type Category struct {
@@ -100,25 +99,40 @@ type CategorySummary struct {
 }
 
 // GetCategoryByID obtiene información detallada de una categoría por su ID
-func GetCategoryByID(ctx context.Context, categoryID, accessToken string) (Category, error) {
-	url := fmt.Sprintf("%s/%s", categoriesEndpoint, categoryID)
+func (c *Client) GetCategoryByID(ctx context.Context, categoryID, accessToken string) (Category, error) {
+	url := c.endpoint("%s/%s", categoriesEndpoint, categoryID)
 	var category Category
-	err := http.DoGetJSON(ctx, url, accessToken, &category)
+	err := http.DoGetJSON(ctx, c, url, accessToken, &category)
 	return category, err
 }
 
+// GetCategoryByID obtiene una categoría usando el Client por defecto
+func GetCategoryByID(ctx context.Context, categoryID, accessToken string) (Category, error) {
+	return defaultClient.GetCategoryByID(ctx, categoryID, accessToken)
+}
+
 // GetCategoriesBySite obtiene el árbol de categorías de un sitio específico
-func GetCategoriesBySite(ctx context.Context, siteID, accessToken string) ([]CategorySummary, error) {
-	url := fmt.Sprintf("%s/%s/categories", siteCategoriesEndpoint, siteID)
+func (c *Client) GetCategoriesBySite(ctx context.Context, siteID, accessToken string) ([]CategorySummary, error) {
+	url := c.endpoint("%s/%s/categories", siteCategoriesEndpoint, siteID)
 	var categories []CategorySummary
-	err := http.DoGetJSON(ctx, url, accessToken, &categories)
+	err := http.DoGetJSON(ctx, c, url, accessToken, &categories)
 	return categories, err
 }
 
+// GetCategoriesBySite obtiene las categorías de un sitio usando el Client por defecto
+func GetCategoriesBySite(ctx context.Context, siteID, accessToken string) ([]CategorySummary, error) {
+	return defaultClient.GetCategoriesBySite(ctx, siteID, accessToken)
+}
+
 // GetCategoryAttributes obtiene los atributos disponibles para una categoría
-func GetCategoryAttributes(ctx context.Context, categoryID, accessToken string) ([]Attr, error) {
-	url := fmt.Sprintf("%s/%s/attributes", categoriesEndpoint, categoryID)
+func (c *Client) GetCategoryAttributes(ctx context.Context, categoryID, accessToken string) ([]Attr, error) {
+	url := c.endpoint("%s/%s/attributes", categoriesEndpoint, categoryID)
 	var attrs []Attr
-	err := http.DoGetJSON(ctx, url, accessToken, &attrs)
+	err := http.DoGetJSON(ctx, c, url, accessToken, &attrs)
 	return attrs, err
+}
+
+// GetCategoryAttributes obtiene los atributos de una categoría usando el Client por defecto
+func GetCategoryAttributes(ctx context.Context, categoryID, accessToken string) ([]Attr, error) {
+	return defaultClient.GetCategoryAttributes(ctx, categoryID, accessToken)
 }
\ No newline at end of file
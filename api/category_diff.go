@@ -0,0 +1,172 @@
+package api
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// CategoryTreeDiff representa los cambios entre dos snapshots del árbol de categorías
+type CategoryTreeDiff struct {
+	Added           []Category               // Categorías nuevas
+	Removed         []Category               // Categorías que ya no existen
+	Renamed         []CategoryRename         // Categorías con otro nombre
+	Moved           []CategoryMove           // Categorías con otro PathFromRoot
+	SettingsChanged []CategorySettingsChange // Categorías con cambios en Settings
+	AttrsChanged    []CategoryAttrsChange    // Categorías con atributos agregados o quitados
+}
+
+// CategoryRename representa un cambio de nombre de una categoría
+type CategoryRename struct {
+	CategoryID string // ID de la categoría
+	OldName    string // Nombre anterior
+	NewName    string // Nombre nuevo
+}
+
+// CategoryMove representa un cambio de ubicación de una categoría en el árbol
+type CategoryMove struct {
+	CategoryID string         // ID de la categoría
+	OldPath    []CategoryPath // PathFromRoot anterior
+	NewPath    []CategoryPath // PathFromRoot nuevo
+}
+
+// CategorySettingsChange representa los cambios de Settings de una categoría
+type CategorySettingsChange struct {
+	CategoryID string          // ID de la categoría
+	Changes    []SettingChange // Campos modificados
+}
+
+// SettingChange representa un campo de CategorySettings modificado
+type SettingChange struct {
+	Field    string // Nombre JSON del campo (ej. "max_pictures_per_item")
+	OldValue string // Valor anterior
+	NewValue string // Valor nuevo
+}
+
+// CategoryAttrsChange representa los atributos agregados o quitados de una categoría
+type CategoryAttrsChange struct {
+	CategoryID string // ID de la categoría
+	Added      []Attr // Atributos nuevos
+	Removed    []Attr // Atributos que ya no existen
+}
+
+// Empty indica si no hay cambios.
+func (d CategoryTreeDiff) Empty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Renamed) == 0 && len(d.Moved) == 0 &&
+		len(d.SettingsChanged) == 0 && len(d.AttrsChanged) == 0
+}
+
+// DiffCategoryTrees compara dos snapshots del árbol de categorías. Los atributos solo se comparan
+// para las categorías que tienen atributos en ambos snapshots. Los resultados se ordenan por ID.
+func DiffCategoryTrees(oldTree, newTree *CategoryTree) CategoryTreeDiff {
+	var diff CategoryTreeDiff
+
+	for _, id := range sortedCategoryIDs(oldTree) {
+		oldCategory := oldTree.Categories[id]
+		newCategory, ok := newTree.Categories[id]
+		if !ok {
+			diff.Removed = append(diff.Removed, oldCategory)
+			continue
+		}
+
+		if oldCategory.Name != newCategory.Name {
+			diff.Renamed = append(diff.Renamed, CategoryRename{CategoryID: id, OldName: oldCategory.Name, NewName: newCategory.Name})
+		}
+		if !samePath(parentPath(oldCategory.PathFromRoot), parentPath(newCategory.PathFromRoot)) {
+			diff.Moved = append(diff.Moved, CategoryMove{CategoryID: id, OldPath: oldCategory.PathFromRoot, NewPath: newCategory.PathFromRoot})
+		}
+		if changes := diffSettings(oldCategory.Settings, newCategory.Settings); len(changes) > 0 {
+			diff.SettingsChanged = append(diff.SettingsChanged, CategorySettingsChange{CategoryID: id, Changes: changes})
+		}
+
+		oldAttrs, oldOK := oldTree.Attrs[id]
+		newAttrs, newOK := newTree.Attrs[id]
+		if oldOK && newOK {
+			if change := diffAttrs(id, oldAttrs, newAttrs); len(change.Added) > 0 || len(change.Removed) > 0 {
+				diff.AttrsChanged = append(diff.AttrsChanged, change)
+			}
+		}
+	}
+
+	for _, id := range sortedCategoryIDs(newTree) {
+		if _, ok := oldTree.Categories[id]; !ok {
+			diff.Added = append(diff.Added, newTree.Categories[id])
+		}
+	}
+	return diff
+}
+
+// sortedCategoryIDs devuelve los IDs de las categorías del árbol ordenados.
+func sortedCategoryIDs(tree *CategoryTree) []string {
+	ids := make([]string, 0, len(tree.Categories))
+	for id := range tree.Categories {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+	return ids
+}
+
+// parentPath devuelve la ruta sin la propia categoría.
+func parentPath(path []CategoryPath) []CategoryPath {
+	if len(path) == 0 {
+		return nil
+	}
+	return path[:len(path)-1]
+}
+
+// diffSettings compara campo a campo dos CategorySettings.
+func diffSettings(oldSettings, newSettings CategorySettings) []SettingChange {
+	var changes []SettingChange
+	oldValue := reflect.ValueOf(oldSettings)
+	newValue := reflect.ValueOf(newSettings)
+	settingsType := oldValue.Type()
+	for i := range settingsType.NumField() {
+		// Se compara el valor formateado para que nil y vacío cuenten como iguales
+		oldField := settingString(oldValue.Field(i).Interface())
+		newField := settingString(newValue.Field(i).Interface())
+		if oldField == newField {
+			continue
+		}
+		name, _, _ := strings.Cut(settingsType.Field(i).Tag.Get("json"), ",")
+		changes = append(changes, SettingChange{Field: name, OldValue: oldField, NewValue: newField})
+	}
+	return changes
+}
+
+// settingString formatea un valor de CategorySettings para mostrarlo.
+func settingString(value any) string {
+	switch v := value.(type) {
+	case *string:
+		if v == nil {
+			return ""
+		}
+		return *v
+	case []string:
+		return strings.Join(v, ",")
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// diffAttrs devuelve los atributos agregados y quitados entre dos listas, por ID.
+func diffAttrs(categoryID string, oldAttrs, newAttrs []Attr) CategoryAttrsChange {
+	change := CategoryAttrsChange{CategoryID: categoryID}
+	oldIDs := make(map[string]bool, len(oldAttrs))
+	for _, attr := range oldAttrs {
+		oldIDs[attr.ID] = true
+	}
+	newIDs := make(map[string]bool, len(newAttrs))
+	for _, attr := range newAttrs {
+		newIDs[attr.ID] = true
+		if !oldIDs[attr.ID] {
+			change.Added = append(change.Added, attr)
+		}
+	}
+	for _, attr := range oldAttrs {
+		if !newIDs[attr.ID] {
+			change.Removed = append(change.Removed, attr)
+		}
+	}
+	return change
+}
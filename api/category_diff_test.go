@@ -0,0 +1,90 @@
+package api
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDiffCategoryTrees(t *testing.T) {
+	oldTree := NewCategoryTree("MLM")
+	oldTree.Roots = []string{"A", "B"}
+	oldTree.Categories["A"] = testCategory([]string{"A"}, "A1", "A2")
+	oldTree.Categories["B"] = testCategory([]string{"B"})
+	oldTree.Categories["A1"] = testCategory([]string{"A", "A1"})
+	oldTree.Categories["A2"] = testCategory([]string{"A", "A2"})
+	oldTree.Attrs["A1"] = []Attr{{ID: "BRAND"}, {ID: "MODEL"}}
+	oldTree.Attrs["A2"] = []Attr{{ID: "BRAND"}} // Sin atributos en el nuevo snapshot: no se compara
+
+	newTree := NewCategoryTree("MLM")
+	newTree.Roots = []string{"A", "B"}
+	newTree.Categories["A"] = testCategory([]string{"A"}, "A1")
+	newTree.Categories["B"] = testCategory([]string{"B"}, "A2", "B1")
+	newTree.Categories["A2"] = testCategory([]string{"B", "A2"})
+	newTree.Categories["B1"] = testCategory([]string{"B", "B1"})
+	a1 := testCategory([]string{"A", "A1"})
+	a1.Name = "Celulares"
+	a1.Settings.ListingAllowed = true
+	a1.Settings.MaxPicturesPerItem = 10
+	newTree.Categories["A1"] = a1
+	newTree.Attrs["A1"] = []Attr{{ID: "BRAND"}, {ID: "COLOR"}}
+
+	diff := DiffCategoryTrees(oldTree, newTree)
+
+	if ids := categoryIDs(diff.Added); !reflect.DeepEqual(ids, []string{"B1"}) {
+		t.Errorf("Added = %v, want [B1]", ids)
+	}
+	if len(diff.Removed) != 0 {
+		t.Errorf("Removed = %v, want vacío", categoryIDs(diff.Removed))
+	}
+	if want := []CategoryRename{{CategoryID: "A1", OldName: "Cat A1", NewName: "Celulares"}}; !reflect.DeepEqual(diff.Renamed, want) {
+		t.Errorf("Renamed = %+v, want %+v", diff.Renamed, want)
+	}
+	// Los cambios de hijos de A y B no cuentan como movimiento: solo cambia el padre de A2
+	if len(diff.Moved) != 1 || diff.Moved[0].CategoryID != "A2" || diff.Moved[0].NewPath[0].ID != "B" {
+		t.Errorf("Moved = %+v, want A2 bajo B", diff.Moved)
+	}
+
+	wantSettings := []CategorySettingsChange{{CategoryID: "A1", Changes: []SettingChange{
+		{Field: "listing_allowed", OldValue: "false", NewValue: "true"},
+		{Field: "max_pictures_per_item", OldValue: "0", NewValue: "10"},
+	}}}
+	if !reflect.DeepEqual(diff.SettingsChanged, wantSettings) {
+		t.Errorf("SettingsChanged = %+v, want %+v", diff.SettingsChanged, wantSettings)
+	}
+
+	if len(diff.AttrsChanged) != 1 {
+		t.Fatalf("AttrsChanged = %+v, want solo A1", diff.AttrsChanged)
+	}
+	change := diff.AttrsChanged[0]
+	if change.CategoryID != "A1" || len(change.Added) != 1 || change.Added[0].ID != "COLOR" ||
+		len(change.Removed) != 1 || change.Removed[0].ID != "MODEL" {
+		t.Errorf("AttrsChanged = %+v, want A1 +COLOR -MODEL", change)
+	}
+
+	if diff.Empty() {
+		t.Error("Empty = true")
+	}
+	if !DiffCategoryTrees(oldTree, oldTree).Empty() {
+		t.Error("diff de un árbol consigo mismo no vacío")
+	}
+}
+
+func TestDiffCategoryTreesRemoved(t *testing.T) {
+	oldTree := NewCategoryTree("MLM")
+	oldTree.Categories["A"] = testCategory([]string{"A"})
+	newTree := NewCategoryTree("MLM")
+
+	diff := DiffCategoryTrees(oldTree, newTree)
+	if ids := categoryIDs(diff.Removed); !reflect.DeepEqual(ids, []string{"A"}) {
+		t.Errorf("Removed = %v, want [A]", ids)
+	}
+}
+
+// categoryIDs devuelve los IDs de categories.
+func categoryIDs(categories []Category) []string {
+	var ids []string
+	for _, category := range categories {
+		ids = append(ids, category.ID)
+	}
+	return ids
+}
@@ -0,0 +1,441 @@
+package api
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// defaultCrawlConcurrency es la cantidad de peticiones simultáneas usada por CrawlCategoryTree.
+const defaultCrawlConcurrency = 8
+
+// CategoryTree representa el árbol completo de categorías de un sitio
+type CategoryTree struct {
+	SiteID     string              `json:"site_id"`              // ID del sitio
+	CrawledAt  time.Time           `json:"crawled_at"`           // Fin del último crawl
+	Roots      []string            `json:"roots"`                // IDs de las categorías raíz, en orden
+	Categories map[string]Category `json:"categories"`           // Categorías por ID
+	Attrs      map[string][]Attr   `json:"attributes,omitempty"` // Atributos por ID de categoría (CrawlOptions.Attributes)
+}
+
+// CategoryTreeIssue representa una inconsistencia detectada por CategoryTree.Verify
+type CategoryTreeIssue struct {
+	CategoryID string // ID de la categoría
+	Problem    string // Descripción del problema
+}
+
+// CrawlOptions configura CrawlCategoryTree
+type CrawlOptions struct {
+	Concurrency int                     // Peticiones simultáneas (por defecto 8)
+	Attributes  bool                    // Obtener también los atributos de cada categoría
+	Resume      *CategoryTree           // Árbol parcial de un crawl anterior; se completa en lugar de empezar de cero
+	OnCategory  func(category Category) // Se invoca (serializado) por cada categoría obtenida
+}
+
+// NewCategoryTree crea un árbol vacío para siteID.
+func NewCategoryTree(siteID string) *CategoryTree {
+	return &CategoryTree{
+		SiteID:     siteID,
+		Categories: make(map[string]Category),
+		Attrs:      make(map[string][]Attr),
+	}
+}
+
+// IsLeaf indica si la categoría id no tiene subcategorías.
+func (t *CategoryTree) IsLeaf(id string) bool {
+	category, ok := t.Categories[id]
+	return ok && len(category.ChildrenCategories) == 0
+}
+
+// Children devuelve las subcategorías obtenidas de id, en el orden de ChildrenCategories.
+func (t *CategoryTree) Children(id string) []Category {
+	var children []Category
+	for _, child := range t.Categories[id].ChildrenCategories {
+		if category, ok := t.Categories[child.ID]; ok {
+			children = append(children, category)
+		}
+	}
+	return children
+}
+
+// Walk recorre el árbol en profundidad desde las raíces; depth es 0 para las raíces.
+// Si fn devuelve false no se recorren las subcategorías de esa categoría.
+func (t *CategoryTree) Walk(fn func(category Category, depth int) bool) {
+	var walk func(id string, depth int)
+	walk = func(id string, depth int) {
+		category, ok := t.Categories[id]
+		if !ok || !fn(category, depth) {
+			return
+		}
+		for _, child := range category.ChildrenCategories {
+			walk(child.ID, depth+1)
+		}
+	}
+	for _, id := range t.Roots {
+		walk(id, 0)
+	}
+}
+
+// Leaves devuelve las categorías hoja (donde se puede publicar), en el orden de Walk.
+func (t *CategoryTree) Leaves() []Category {
+	var leaves []Category
+	t.Walk(func(category Category, depth int) bool {
+		if len(category.ChildrenCategories) == 0 {
+			leaves = append(leaves, category)
+		}
+		return true
+	})
+	return leaves
+}
+
+// Pending devuelve los IDs referenciados (raíces o subcategorías) que aún no se obtuvieron.
+func (t *CategoryTree) Pending() []string {
+	var pending []string
+	for _, id := range t.Roots {
+		if _, ok := t.Categories[id]; !ok {
+			pending = append(pending, id)
+		}
+	}
+	for _, category := range t.Categories {
+		for _, child := range category.ChildrenCategories {
+			if _, ok := t.Categories[child.ID]; !ok {
+				pending = append(pending, child.ID)
+			}
+		}
+	}
+	return pending
+}
+
+// Verify comprueba que el PathFromRoot de cada categoría coincida con su posición en el árbol:
+// que termine en la propia categoría, que el penúltimo elemento sea la categoría que la lista
+// como subcategoría y que el resto coincida con el path del padre.
+func (t *CategoryTree) Verify() []CategoryTreeIssue {
+	var issues []CategoryTreeIssue
+	report := func(id, format string, args ...any) {
+		issues = append(issues, CategoryTreeIssue{CategoryID: id, Problem: fmt.Sprintf(format, args...)})
+	}
+
+	parents := make(map[string]string, len(t.Categories))
+	for _, id := range t.Roots {
+		parents[id] = ""
+	}
+	for id, category := range t.Categories {
+		for _, child := range category.ChildrenCategories {
+			if previous, ok := parents[child.ID]; ok && previous != id {
+				report(child.ID, "listada como subcategoría de %q y de %q", previous, id)
+			}
+			parents[child.ID] = id
+		}
+	}
+
+	for _, id := range t.Pending() {
+		report(id, "referenciada pero no obtenida")
+	}
+
+	for id, category := range t.Categories {
+		path := category.PathFromRoot
+		if len(path) == 0 || path[len(path)-1].ID != id {
+			report(id, "path_from_root no termina en la categoría")
+			continue
+		}
+		parentID, ok := parents[id]
+		if !ok {
+			report(id, "no es raíz ni subcategoría de ninguna categoría obtenida")
+			continue
+		}
+		if parentID == "" {
+			if len(path) != 1 {
+				report(id, "raíz con path_from_root de %d elementos", len(path))
+			}
+			continue
+		}
+		if len(path) < 2 || path[len(path)-2].ID != parentID {
+			report(id, "path_from_root no pasa por su padre %q", parentID)
+			continue
+		}
+		parentPath := t.Categories[parentID].PathFromRoot
+		if !samePath(path[:len(path)-1], parentPath) {
+			report(id, "path_from_root no coincide con el de su padre %q", parentID)
+		}
+	}
+	return issues
+}
+
+// samePath compara dos rutas por ID.
+func samePath(a, b []CategoryPath) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].ID != b[i].ID {
+			return false
+		}
+	}
+	return true
+}
+
+// WriteJSON escribe el árbol como snapshot JSON.
+func (t *CategoryTree) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(t)
+}
+
+// WriteCSV escribe una fila por categoría, en el orden de Walk, con las columnas
+// id, name, parent_id, depth, leaf, path, listing_allowed, total_items.
+func (t *CategoryTree) WriteCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write([]string{"id", "name", "parent_id", "depth", "leaf", "path", "listing_allowed", "total_items"}); err != nil {
+		return err
+	}
+
+	var err error
+	t.Walk(func(category Category, depth int) bool {
+		names := make([]string, 0, len(category.PathFromRoot))
+		for _, p := range category.PathFromRoot {
+			names = append(names, p.Name)
+		}
+		parentID := ""
+		if len(category.PathFromRoot) >= 2 {
+			parentID = category.PathFromRoot[len(category.PathFromRoot)-2].ID
+		}
+		err = writer.Write([]string{
+			category.ID,
+			category.Name,
+			parentID,
+			strconv.Itoa(depth),
+			strconv.FormatBool(len(category.ChildrenCategories) == 0),
+			strings.Join(names, " > "),
+			strconv.FormatBool(category.Settings.ListingAllowed),
+			strconv.FormatInt(category.TotalItemsInCategory, 10),
+		})
+		return err == nil
+	})
+	if err != nil {
+		return err
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// SaveJSON guarda el snapshot JSON en path de forma atómica.
+func (t *CategoryTree) SaveJSON(path string) error {
+	return writeFileAtomic(path, t.WriteJSON)
+}
+
+// SaveCSV guarda el snapshot CSV en path de forma atómica.
+func (t *CategoryTree) SaveCSV(path string) error {
+	return writeFileAtomic(path, t.WriteCSV)
+}
+
+// ReadCategoryTree lee un snapshot JSON escrito por WriteJSON.
+func ReadCategoryTree(r io.Reader) (*CategoryTree, error) {
+	tree := &CategoryTree{}
+	if err := json.NewDecoder(r).Decode(tree); err != nil {
+		return nil, err
+	}
+	if tree.Categories == nil {
+		tree.Categories = make(map[string]Category)
+	}
+	if tree.Attrs == nil {
+		tree.Attrs = make(map[string][]Attr)
+	}
+	return tree, nil
+}
+
+// LoadCategoryTree lee el snapshot JSON guardado en path.
+func LoadCategoryTree(path string) (*CategoryTree, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return ReadCategoryTree(file)
+}
+
+// writeFileAtomic escribe path mediante un archivo temporal y rename.
+func writeFileAtomic(path string, write func(io.Writer) error) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".snapshot-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if err := write(tmp); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// CrawlCategoryTree obtiene el árbol completo de categorías de siteID recorriendo por niveles
+// ChildrenCategories con GetCategoryByID. Ante un error devuelve también el árbol parcial,
+// que puede guardarse y pasarse en CrawlOptions.Resume para continuar sin repetir peticiones.
+func (c *Client) CrawlCategoryTree(ctx context.Context, siteID string, opts CrawlOptions, accessToken string) (*CategoryTree, error) {
+	tree := opts.Resume
+	if tree == nil {
+		tree = NewCategoryTree(siteID)
+	} else if tree.SiteID != siteID {
+		return nil, fmt.Errorf("el árbol a continuar es del sitio %s, no de %s", tree.SiteID, siteID)
+	}
+	if tree.Categories == nil {
+		tree.Categories = make(map[string]Category)
+	}
+	if opts.Concurrency <= 0 {
+		opts.Concurrency = defaultCrawlConcurrency
+	}
+
+	if len(tree.Roots) == 0 {
+		roots, err := c.GetCategoriesBySite(ctx, siteID, accessToken)
+		if err != nil {
+			return tree, err
+		}
+		for _, root := range roots {
+			tree.Roots = append(tree.Roots, root.ID)
+		}
+	}
+
+	crawler := &categoryCrawler{client: c, tree: tree, opts: opts, accessToken: accessToken, seen: make(map[string]bool)}
+	level := crawler.unseen(tree.Roots)
+	for len(level) > 0 {
+		next, err := crawler.crawlLevel(ctx, level)
+		if err != nil {
+			return tree, err
+		}
+		level = next
+	}
+	tree.CrawledAt = time.Now()
+	return tree, nil
+}
+
+// CrawlCategoryTree obtiene el árbol de categorías usando el Client por defecto
+func CrawlCategoryTree(ctx context.Context, siteID string, opts CrawlOptions, accessToken string) (*CategoryTree, error) {
+	return defaultClient.CrawlCategoryTree(ctx, siteID, opts, accessToken)
+}
+
+// categoryCrawler mantiene el estado compartido de un crawl (uso interno)
+type categoryCrawler struct {
+	client      *Client
+	tree        *CategoryTree
+	opts        CrawlOptions
+	accessToken string
+
+	mu   sync.Mutex
+	seen map[string]bool
+}
+
+// unseen marca como vistos y devuelve los IDs que no se habían encolado; debe llamarse con mu tomado.
+func (cr *categoryCrawler) unseen(ids []string) []string {
+	var fresh []string
+	for _, id := range ids {
+		if !cr.seen[id] {
+			cr.seen[id] = true
+			fresh = append(fresh, id)
+		}
+	}
+	return fresh
+}
+
+// crawlLevel obtiene las categorías de ids que faltan en el árbol y devuelve el siguiente nivel.
+func (cr *categoryCrawler) crawlLevel(ctx context.Context, ids []string) ([]string, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var next []string
+	var firstErr error
+	jobs := make(chan string)
+
+	var wg sync.WaitGroup
+	for range min(cr.opts.Concurrency, len(ids)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for id := range jobs {
+				if err := cr.visit(ctx, id, &next); err != nil {
+					cr.mu.Lock()
+					if firstErr == nil {
+						firstErr = err
+					}
+					cr.mu.Unlock()
+					cancel()
+				}
+			}
+		}()
+	}
+
+feed:
+	for _, id := range ids {
+		select {
+		case jobs <- id:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	return next, ctx.Err()
+}
+
+// visit obtiene la categoría id (y sus atributos) si faltan y agrega sus subcategorías a next.
+func (cr *categoryCrawler) visit(ctx context.Context, id string, next *[]string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	cr.mu.Lock()
+	category, haveCategory := cr.tree.Categories[id]
+	_, haveAttrs := cr.tree.Attrs[id]
+	cr.mu.Unlock()
+
+	var fetched bool
+	if !haveCategory {
+		var err error
+		category, err = cr.client.GetCategoryByID(ctx, id, cr.accessToken)
+		if err != nil {
+			return fmt.Errorf("categoría %s: %w", id, err)
+		}
+		fetched = true
+	}
+	var attrs []Attr
+	if cr.opts.Attributes && !haveAttrs {
+		var err error
+		attrs, err = cr.client.GetCategoryAttributes(ctx, id, cr.accessToken)
+		if err != nil {
+			return fmt.Errorf("atributos de %s: %w", id, err)
+		}
+	}
+
+	children := make([]string, 0, len(category.ChildrenCategories))
+	for _, child := range category.ChildrenCategories {
+		children = append(children, child.ID)
+	}
+
+	cr.mu.Lock()
+	defer cr.mu.Unlock()
+	cr.tree.Categories[id] = category
+	if cr.opts.Attributes && !haveAttrs {
+		if cr.tree.Attrs == nil {
+			cr.tree.Attrs = make(map[string][]Attr)
+		}
+		cr.tree.Attrs[id] = attrs
+	}
+	*next = append(*next, cr.unseen(children)...)
+	if fetched && cr.opts.OnCategory != nil {
+		cr.opts.OnCategory(category)
+	}
+	return nil
+}
@@ -0,0 +1,130 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	nethttp "net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+// testCategory arma una categoría con su path y subcategorías.
+func testCategory(path []string, children ...string) Category {
+	category := Category{ID: path[len(path)-1], Name: "Cat " + path[len(path)-1]}
+	for _, id := range path {
+		category.PathFromRoot = append(category.PathFromRoot, CategoryPath{ID: id, Name: "Cat " + id})
+	}
+	for _, child := range children {
+		category.ChildrenCategories = append(category.ChildrenCategories, CategorySummary{ID: child})
+	}
+	return category
+}
+
+func TestCrawlCategoryTreeResume(t *testing.T) {
+	categories := map[string]Category{
+		"A":  testCategory([]string{"A"}, "A1", "A2"),
+		"B":  testCategory([]string{"B"}, "B1"),
+		"A1": testCategory([]string{"A", "A1"}),
+		"A2": testCategory([]string{"A", "A2"}),
+		"B1": testCategory([]string{"B", "B1"}),
+	}
+
+	var failA2 atomic.Bool
+	failA2.Store(true)
+	var mu sync.Mutex
+	served := make(map[string]int) // Respuestas exitosas por path
+	srv := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		var body any
+		switch {
+		case r.URL.Path == "/sites/MLM/categories":
+			body = []CategorySummary{{ID: "A"}, {ID: "B"}}
+		case strings.HasSuffix(r.URL.Path, "/attributes"):
+			body = []Attr{{ID: "BRAND"}}
+		default:
+			id := strings.TrimPrefix(r.URL.Path, "/categories/")
+			if id == "A2" && failA2.Load() {
+				w.WriteHeader(nethttp.StatusInternalServerError)
+				w.Write([]byte(`{"message":"boom"}`))
+				return
+			}
+			body = categories[id]
+		}
+		mu.Lock()
+		served[r.URL.Path]++
+		mu.Unlock()
+		json.NewEncoder(w).Encode(body)
+	}))
+	defer srv.Close()
+	client := NewClient(WithBaseURL(srv.URL))
+
+	// Con concurrencia 1 el orden es determinista: A2 falla antes de pedir B1
+	partial, err := client.CrawlCategoryTree(context.Background(), "MLM", CrawlOptions{Concurrency: 1, Attributes: true}, "")
+	if !IsServerError(err) {
+		t.Fatalf("err = %v, want 500", err)
+	}
+	if served["/categories/B1"] != 0 {
+		t.Error("se pidió B1 después del primer error")
+	}
+	if pending := partial.Pending(); !slices.Contains(pending, "A2") || !slices.Contains(pending, "B1") {
+		t.Errorf("Pending = %v, want A2 y B1", pending)
+	}
+
+	failA2.Store(false)
+	var fetched []string
+	tree, err := client.CrawlCategoryTree(context.Background(), "MLM", CrawlOptions{
+		Concurrency: 4,
+		Attributes:  true,
+		Resume:      partial,
+		OnCategory:  func(category Category) { fetched = append(fetched, category.ID) },
+	}, "")
+	if err != nil {
+		t.Fatalf("Resume: %v", err)
+	}
+
+	slices.Sort(fetched)
+	if !slices.Equal(fetched, []string{"A2", "B1"}) {
+		t.Errorf("obtenidas al continuar = %v, want [A2 B1]", fetched)
+	}
+	for path, count := range served {
+		if count != 1 {
+			t.Errorf("%s pedido %d veces con éxito, want 1", path, count)
+		}
+	}
+	if len(tree.Categories) != len(categories) || len(tree.Attrs) != len(categories) {
+		t.Errorf("árbol con %d categorías y %d atributos, want %d", len(tree.Categories), len(tree.Attrs), len(categories))
+	}
+	if issues := tree.Verify(); len(issues) > 0 {
+		t.Errorf("Verify = %v", issues)
+	}
+	if tree.CrawledAt.IsZero() {
+		t.Error("CrawledAt vacío")
+	}
+}
+
+func TestCategoryTreeVerifyPathMismatch(t *testing.T) {
+	tree := NewCategoryTree("MLM")
+	tree.Roots = []string{"A", "B"}
+	tree.Categories["A"] = testCategory([]string{"A"}, "A1", "A2")
+	tree.Categories["B"] = testCategory([]string{"B"})
+	tree.Categories["A1"] = testCategory([]string{"B", "A1"}) // Dice estar bajo B
+	tree.Categories["A2"] = testCategory([]string{"A", "X"})  // No termina en sí misma
+
+	issues := tree.Verify()
+	problems := make(map[string]string, len(issues))
+	for _, issue := range issues {
+		problems[issue.CategoryID] = issue.Problem
+	}
+	if len(issues) != 2 {
+		t.Errorf("issues = %v, want 2", issues)
+	}
+	if !strings.Contains(problems["A1"], "no pasa por su padre") {
+		t.Errorf("A1: %q", problems["A1"])
+	}
+	if !strings.Contains(problems["A2"], "no termina en la categoría") {
+		t.Errorf("A2: %q", problems["A2"])
+	}
+}
@@ -0,0 +1,291 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	nethttp "net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/tidyrocks/mercado-libre-go-sdk/internal/http"
+)
+
+const defaultBaseURL = "https://api.mercadolibre.com"
+const defaultUserAgent = "mercado-libre-go-sdk"
+
+// Client concentra la configuración de acceso a la API de Mercado Libre.
+// Es seguro para uso concurrente y puede haber varios con configuraciones distintas en un mismo proceso.
+type Client struct {
+	baseURL    string          // URL base de la API (sin "/" final)
+	httpClient *nethttp.Client // Cliente HTTP subyacente
+	userAgent  string          // User-Agent enviado en cada petición
+	token      string          // Token usado cuando la función no recibe uno
+	retry      RetryPolicy     // Política de reintentos (sin reintentos por defecto)
+	tokens     TokenSource     // Fuente de tokens (tiene prioridad sobre token)
+	limiter    *RateLimiter    // Limitador de peticiones (opcional)
+}
+
+// Option configura un Client en NewClient.
+type Option func(*Client)
+
+// WithBaseURL cambia la URL base (útil para apuntar a un servidor local de pruebas).
+func WithBaseURL(baseURL string) Option {
+	return func(c *Client) {
+		c.baseURL = strings.TrimRight(baseURL, "/")
+	}
+}
+
+// WithHTTPClient usa el *http.Client indicado en lugar de uno propio.
+func WithHTTPClient(httpClient *nethttp.Client) Option {
+	return func(c *Client) {
+		if httpClient != nil {
+			c.httpClient = httpClient
+		}
+	}
+}
+
+// WithTransport usa rt como RoundTripper del cliente HTTP.
+func WithTransport(rt nethttp.RoundTripper) Option {
+	return func(c *Client) {
+		httpClient := *c.httpClient
+		httpClient.Transport = rt
+		c.httpClient = &httpClient
+	}
+}
+
+// WithTimeout fija el timeout total de cada petición HTTP.
+func WithTimeout(timeout time.Duration) Option {
+	return func(c *Client) {
+		httpClient := *c.httpClient
+		httpClient.Timeout = timeout
+		c.httpClient = &httpClient
+	}
+}
+
+// WithUserAgent cambia el User-Agent enviado en cada petición.
+func WithUserAgent(userAgent string) Option {
+	return func(c *Client) {
+		c.userAgent = userAgent
+	}
+}
+
+// WithToken fija el access token usado cuando una función recibe accessToken vacío.
+func WithToken(accessToken string) Option {
+	return func(c *Client) {
+		c.token = accessToken
+	}
+}
+
+// WithTokenSource obtiene el access token de ts cuando una función recibe accessToken vacío.
+// Si la API responde 401 y ts permite renovar (ej. RefreshingTokenSource), se renueva y se reintenta una vez.
+func WithTokenSource(ts TokenSource) Option {
+	return func(c *Client) {
+		c.tokens = ts
+	}
+}
+
+// NewClient crea un Client con los valores por defecto modificados por opts.
+func NewClient(opts ...Option) *Client {
+	c := &Client{
+		baseURL:    defaultBaseURL,
+		httpClient: &nethttp.Client{},
+		userAgent:  defaultUserAgent,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// defaultClient es el Client usado por las funciones de paquete (GetItem, GetSites, etc.).
+var defaultClient = NewClient()
+
+// DefaultClient devuelve el Client usado por las funciones de paquete.
+func DefaultClient() *Client {
+	return defaultClient
+}
+
+// anonymousKey marca en el contexto las peticiones que no deben llevar el token por defecto.
+type anonymousKey struct{}
+
+// anonymous devuelve un contexto cuyas peticiones se envían sin el token por defecto del Client.
+func anonymous(ctx context.Context) context.Context {
+	return context.WithValue(ctx, anonymousKey{}, true)
+}
+
+// userIDKey guarda en el contexto el UserID del token obtenido de la TokenSource.
+type userIDKey struct{}
+
+// requestUserID devuelve el UserID de la petición: el de la TokenSource si está en el contexto
+// o, si no, el que se deduce del access token del header Authorization.
+func requestUserID(req *nethttp.Request) int64 {
+	if userID, ok := req.Context().Value(userIDKey{}).(int64); ok && userID != 0 {
+		return userID
+	}
+	return userIDFromAuthorization(req.Header.Get("Authorization"))
+}
+
+// Do ejecuta req con el cliente HTTP configurado, agregando User-Agent y el token por defecto si falta.
+// Las respuestas con status >= 400 se consumen y se devuelven como *Error.
+// Si el Client tiene RetryPolicy, los errores transitorios se reintentan con backoff.
+func (c *Client) Do(req *nethttp.Request) (*nethttp.Response, error) {
+	if c.userAgent != "" && req.Header.Get("User-Agent") == "" {
+		req.Header.Set("User-Agent", c.userAgent)
+	}
+	if req.Header.Get("Authorization") != "" || req.Context().Value(anonymousKey{}) != nil {
+		return c.doWithRetry(req)
+	}
+
+	if c.tokens == nil {
+		if c.token != "" {
+			req.Header.Set("Authorization", "Bearer "+c.token)
+		}
+		return c.doWithRetry(req)
+	}
+
+	token, err := c.tokens.Token(req.Context())
+	if err != nil {
+		return nil, err
+	}
+	if token.UserID != 0 {
+		req = req.WithContext(context.WithValue(req.Context(), userIDKey{}, token.UserID))
+	}
+	req.Header.Set("Authorization", "Bearer "+token.AccessToken)
+
+	resp, err := c.doWithRetry(req)
+	refresher, ok := c.tokens.(tokenRefresher)
+	if !ok || !IsUnauthorized(err) {
+		return resp, err
+	}
+
+	// Token revocado o expirado antes de lo previsto: se renueva y se reintenta una vez
+	token, refreshErr := refresher.forceRefresh(req.Context(), token.AccessToken)
+	if refreshErr != nil {
+		return nil, errors.Join(err, refreshErr)
+	}
+	// Un cuerpo en streaming ya consumido no se puede reenviar: se devuelve el 401 original
+	retryReq, rewindErr := rewind(req)
+	if rewindErr != nil {
+		return nil, err
+	}
+	retryReq.Header.Set("Authorization", "Bearer "+token.AccessToken)
+	return c.doWithRetry(retryReq)
+}
+
+// doWithRetry envía req aplicando la RetryPolicy del Client.
+func (c *Client) doWithRetry(req *nethttp.Request) (*nethttp.Response, error) {
+	for attempt := 1; ; attempt++ {
+		resp, err := c.send(req)
+		retry := c.retry.shouldRetry(req, attempt, err)
+
+		var delay time.Duration
+		if retry {
+			delay = c.retry.delay(attempt, err)
+		}
+		if c.retry.OnAttempt != nil {
+			c.retry.OnAttempt(RetryAttempt{
+				Attempt:    attempt,
+				Method:     req.Method,
+				URL:        req.URL.String(),
+				StatusCode: statusCode(resp, err),
+				Err:        err,
+				Retry:      retry,
+				Delay:      delay,
+			})
+		}
+		if !retry {
+			return resp, err
+		}
+
+		if sleepErr := sleep(req.Context(), delay); sleepErr != nil {
+			return nil, err
+		}
+		if req, err = rewind(req); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// send hace un único intento y convierte los status >= 400 en *Error.
+func (c *Client) send(req *nethttp.Request) (*nethttp.Response, error) {
+	if c.limiter != nil {
+		userID := requestUserID(req)
+		family := endpointFamily(req.URL.Path, c.basePath())
+		if err := c.limiter.Wait(req.Context(), userID, family); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= 400 {
+		return nil, newError(resp)
+	}
+	return resp, nil
+}
+
+// errNotRewindable indica que el cuerpo de una petición ya se consumió y no puede reenviarse.
+var errNotRewindable = errors.New("el cuerpo de la petición no se puede reenviar")
+
+// rewind clona req con un cuerpo nuevo para reenviarla.
+func rewind(req *nethttp.Request) (*nethttp.Request, error) {
+	next := req.Clone(req.Context())
+	if req.Body != nil && req.Body != nethttp.NoBody && req.GetBody == nil {
+		return nil, errNotRewindable
+	}
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		next.Body = body
+	}
+	return next, nil
+}
+
+// statusCode obtiene el status HTTP de una respuesta o de un *Error.
+func statusCode(resp *nethttp.Response, err error) int {
+	if resp != nil {
+		return resp.StatusCode
+	}
+	var apiErr *Error
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode
+	}
+	return 0
+}
+
+// GetStream hace GET a path (relativo a la URL base, ej. "/sites/MLM/search") y devuelve el cuerpo
+// sin decodificar, para procesar respuestas grandes con DecodeArray. El llamador debe cerrarlo.
+func (c *Client) GetStream(ctx context.Context, path string, params url.Values, accessToken string) (io.ReadCloser, error) {
+	u, err := http.WithParams(c.endpoint("%s", path), params)
+	if err != nil {
+		return nil, err
+	}
+	return http.DoStream(ctx, c, nethttp.MethodGet, u, accessToken, "", nil)
+}
+
+// DecodeArray recorre un arreglo JSON de r elemento por elemento sin cargarlo completo en memoria.
+// path indica el campo que contiene el arreglo ("" si es la raíz, "a.b" para campos anidados).
+func DecodeArray[T any](r io.Reader, path string, fn func(T) error) error {
+	return http.DecodeArray(r, path, fn)
+}
+
+// basePath devuelve el path de la URL base (vacío para https://api.mercadolibre.com).
+func (c *Client) basePath() string {
+	u, err := url.Parse(c.baseURL)
+	if err != nil {
+		return ""
+	}
+	return u.Path
+}
+
+// endpoint arma la URL absoluta de un recurso a partir de la URL base.
+func (c *Client) endpoint(format string, args ...any) string {
+	return c.baseURL + fmt.Sprintf(format, args...)
+}
@@ -0,0 +1,87 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"unicode/utf8"
+
+	"github.com/tidyrocks/mercado-libre-go-sdk/internal/http"
+)
+
+// ErrDescriptionTooLong indica que una descripción supera CategorySettings.MaxDescriptionLength
+var ErrDescriptionTooLong = errors.New("descripción demasiado larga")
+
+// Description representa la descripción de un ítem
+type Description struct {
+	Text        string    `json:"text"`         // Texto con formato (legado, normalmente vacío)
+	PlainText   string    `json:"plain_text"`   // Texto plano visible en la publicación
+	LastUpdated time.Time `json:"last_updated"` // Fecha de última actualización
+	DateCreated time.Time `json:"date_created"` // Fecha de creación
+}
+
+// DescriptionRequest representa el cuerpo para crear o actualizar una descripción
+type DescriptionRequest struct {
+	PlainText string `json:"plain_text"` // Texto plano
+}
+
+// ValidateDescription verifica plainText contra el largo máximo de la categoría (0 = sin límite)
+func ValidateDescription(plainText string, settings CategorySettings) error {
+	length := utf8.RuneCountInString(plainText)
+	if settings.MaxDescriptionLength > 0 && length > settings.MaxDescriptionLength {
+		return fmt.Errorf("%w: %d caracteres, máximo %d", ErrDescriptionTooLong, length, settings.MaxDescriptionLength)
+	}
+	return nil
+}
+
+// GetItemDescription obtiene la descripción de un ítem
+func (c *Client) GetItemDescription(ctx context.Context, itemID, accessToken string) (Description, error) {
+	url := c.endpoint("%s/%s/description", itemsEndpoint, itemID)
+	var description Description
+	err := http.DoGetJSON(ctx, c, url, accessToken, &description)
+	return description, err
+}
+
+// GetItemDescription obtiene la descripción de un ítem usando el Client por defecto
+func GetItemDescription(ctx context.Context, itemID, accessToken string) (Description, error) {
+	return defaultClient.GetItemDescription(ctx, itemID, accessToken)
+}
+
+// CreateItemDescription agrega la descripción a un ítem que no tiene.
+// Si settings no es nil, se valida el largo antes de enviar.
+func (c *Client) CreateItemDescription(ctx context.Context, itemID, plainText string, settings *CategorySettings, accessToken string) (Description, error) {
+	if settings != nil {
+		if err := ValidateDescription(plainText, *settings); err != nil {
+			return Description{}, err
+		}
+	}
+	url := c.endpoint("%s/%s/description", itemsEndpoint, itemID)
+	var description Description
+	err := http.DoPostJSON(ctx, c, url, accessToken, DescriptionRequest{PlainText: plainText}, &description)
+	return description, err
+}
+
+// CreateItemDescription agrega la descripción a un ítem usando el Client por defecto
+func CreateItemDescription(ctx context.Context, itemID, plainText string, settings *CategorySettings, accessToken string) (Description, error) {
+	return defaultClient.CreateItemDescription(ctx, itemID, plainText, settings, accessToken)
+}
+
+// UpdateItemDescription reemplaza la descripción de un ítem.
+// Si settings no es nil, se valida el largo antes de enviar.
+func (c *Client) UpdateItemDescription(ctx context.Context, itemID, plainText string, settings *CategorySettings, accessToken string) (Description, error) {
+	if settings != nil {
+		if err := ValidateDescription(plainText, *settings); err != nil {
+			return Description{}, err
+		}
+	}
+	url := c.endpoint("%s/%s/description?api_version=2", itemsEndpoint, itemID)
+	var description Description
+	err := http.DoPutJSON(ctx, c, url, accessToken, DescriptionRequest{PlainText: plainText}, &description)
+	return description, err
+}
+
+// UpdateItemDescription reemplaza la descripción de un ítem usando el Client por defecto
+func UpdateItemDescription(ctx context.Context, itemID, plainText string, settings *CategorySettings, accessToken string) (Description, error) {
+	return defaultClient.UpdateItemDescription(ctx, itemID, plainText, settings, accessToken)
+}
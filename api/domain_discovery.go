@@ -0,0 +1,124 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/tidyrocks/mercado-libre-go-sdk/internal/http"
+)
+
+// ErrNoDomainPrediction indica que domain_discovery no devolvió ninguna predicción para el texto.
+var ErrNoDomainPrediction = errors.New("sin predicción de dominio")
+
+// DomainPrediction representa una predicción de dominio y categoría para un texto
+type DomainPrediction struct {
+	DomainID     string `json:"domain_id"`     // ID del dominio (ej. MLM-CELLPHONES)
+	DomainName   string `json:"domain_name"`   // Nombre del dominio
+	CategoryID   string `json:"category_id"`   // ID de la categoría sugerida
+	CategoryName string `json:"category_name"` // Nombre de la categoría sugerida
+	// Arrays y slices
+	Attrs []PredictedAttr `json:"attributes"` // Atributos inferidos del texto
+}
+
+// PredictedAttr representa un atributo inferido por domain_discovery
+type PredictedAttr struct {
+	ID        string `json:"id"`         // ID del atributo (ej. BRAND)
+	Name      string `json:"name"`       // Nombre del atributo
+	ValueName string `json:"value_name"` // Valor inferido
+	// Campos opcionales (punteros)
+	ValueID *string `json:"value_id,omitempty"` // ID del valor (atributos tipo list)
+}
+
+// ListingTemplate representa la plantilla de atributos para publicar un título en la categoría predicha
+type ListingTemplate struct {
+	Prediction DomainPrediction // Predicción usada
+	Category   Category         // Categoría predicha
+	// Arrays y slices
+	CategoryAttrs []Attr        // Definición de los atributos de la categoría
+	Attrs         []AttrRequest // Atributos editables, con los valores inferidos ya cargados
+}
+
+// Missing devuelve los IDs de los atributos requeridos que aún no tienen valor.
+func (t ListingTemplate) Missing() []string {
+	values := make(map[string]bool, len(t.Attrs))
+	for _, attr := range t.Attrs {
+		values[attr.ID] = attr.ValueName != "" || attr.ValueID != nil || attr.ValueStruct != nil
+	}
+	var missing []string
+	for _, attr := range t.CategoryAttrs {
+		if filled, editable := values[attr.ID]; editable && !filled && attr.Tags["required"] {
+			missing = append(missing, attr.ID)
+		}
+	}
+	return missing
+}
+
+// DiscoverDomains predice dominios y categorías para query (ej. el título de una publicación).
+// limit <= 0 usa el valor por defecto de la API.
+func (c *Client) DiscoverDomains(ctx context.Context, siteID, query string, limit int, accessToken string) ([]DomainPrediction, error) {
+	params := url.Values{}
+	params.Set("q", query)
+	if limit > 0 {
+		params.Set("limit", strconv.Itoa(limit))
+	}
+
+	url := c.endpoint("%s/%s/domain_discovery/search", sitesEndpoint, siteID)
+	var predictions []DomainPrediction
+	err := http.DoGetJSONWithParams(ctx, c, url, accessToken, params, &predictions)
+	return predictions, err
+}
+
+// DiscoverDomains predice dominios y categorías usando el Client por defecto
+func DiscoverDomains(ctx context.Context, siteID, query string, limit int, accessToken string) ([]DomainPrediction, error) {
+	return defaultClient.DiscoverDomains(ctx, siteID, query, limit, accessToken)
+}
+
+// PredictListingTemplate predice la categoría de title y arma la plantilla de atributos a completar,
+// combinando domain_discovery con GetCategoryByID y GetCategoryAttributes.
+func (c *Client) PredictListingTemplate(ctx context.Context, siteID, title, accessToken string) (ListingTemplate, error) {
+	predictions, err := c.DiscoverDomains(ctx, siteID, title, 1, accessToken)
+	if err != nil {
+		return ListingTemplate{}, err
+	}
+	if len(predictions) == 0 {
+		return ListingTemplate{}, fmt.Errorf("%w: %q", ErrNoDomainPrediction, title)
+	}
+	prediction := predictions[0]
+
+	category, err := c.GetCategoryByID(ctx, prediction.CategoryID, accessToken)
+	if err != nil {
+		return ListingTemplate{}, err
+	}
+	attrs, err := c.GetCategoryAttributes(ctx, prediction.CategoryID, accessToken)
+	if err != nil {
+		return ListingTemplate{}, err
+	}
+
+	predicted := make(map[string]PredictedAttr, len(prediction.Attrs))
+	for _, attr := range prediction.Attrs {
+		predicted[attr.ID] = attr
+	}
+
+	template := ListingTemplate{Prediction: prediction, Category: category, CategoryAttrs: attrs}
+	for _, attr := range attrs {
+		// Los atributos de solo lectura, ocultos o fijos no se envían al publicar
+		if attr.Tags["read_only"] || attr.Tags["hidden"] || attr.Tags["fixed"] {
+			continue
+		}
+		request := AttrRequest{ID: attr.ID}
+		if value, ok := predicted[attr.ID]; ok {
+			request.ValueID = value.ValueID
+			request.ValueName = value.ValueName
+		}
+		template.Attrs = append(template.Attrs, request)
+	}
+	return template, nil
+}
+
+// PredictListingTemplate arma la plantilla de atributos usando el Client por defecto
+func PredictListingTemplate(ctx context.Context, siteID, title, accessToken string) (ListingTemplate, error) {
+	return defaultClient.PredictListingTemplate(ctx, siteID, title, accessToken)
+}
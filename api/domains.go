@@ -2,12 +2,11 @@ package api
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/tidyrocks/mercado-libre-go-sdk/internal/http"
 )
 
-const domainsEndpoint = "https://api.mercadolibre.com/catalog_domains"
+const domainsEndpoint = "/catalog_domains"
 
 // Domain representa un dominio de productos en Mercado Libre
 type Domain struct {
@@ -41,17 +40,27 @@ type ShippingAttribute struct {
 }
 
 // GetDomainByID obtiene información detallada de un dominio por su ID
-func GetDomainByID(ctx context.Context, domainID, accessToken string) (Domain, error) {
-	url := fmt.Sprintf("%s/%s", domainsEndpoint, domainID)
+func (c *Client) GetDomainByID(ctx context.Context, domainID, accessToken string) (Domain, error) {
+	url := c.endpoint("%s/%s", domainsEndpoint, domainID)
 	var domain Domain
-	err := http.DoGetJSON(ctx, url, accessToken, &domain)
+	err := http.DoGetJSON(ctx, c, url, accessToken, &domain)
 	return domain, err
 }
 
+// GetDomainByID obtiene un dominio usando el Client por defecto
+func GetDomainByID(ctx context.Context, domainID, accessToken string) (Domain, error) {
+	return defaultClient.GetDomainByID(ctx, domainID, accessToken)
+}
+
 // GetDomainShippingAttributes obtiene los atributos de envío requeridos para un dominio
-func GetDomainShippingAttributes(ctx context.Context, domainID, accessToken string) (DomainShippingAttributes, error) {
-	url := fmt.Sprintf("%s/%s/shipping_attributes", domainsEndpoint, domainID)
+func (c *Client) GetDomainShippingAttributes(ctx context.Context, domainID, accessToken string) (DomainShippingAttributes, error) {
+	url := c.endpoint("%s/%s/shipping_attributes", domainsEndpoint, domainID)
 	var shippingAttrs DomainShippingAttributes
-	err := http.DoGetJSON(ctx, url, accessToken, &shippingAttrs)
+	err := http.DoGetJSON(ctx, c, url, accessToken, &shippingAttrs)
 	return shippingAttrs, err
+}
+
+// GetDomainShippingAttributes obtiene los atributos de envío de un dominio usando el Client por defecto
+func GetDomainShippingAttributes(ctx context.Context, domainID, accessToken string) (DomainShippingAttributes, error) {
+	return defaultClient.GetDomainShippingAttributes(ctx, domainID, accessToken)
 }
\ No newline at end of file
@@ -0,0 +1,153 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	nethttp "net/http"
+	"strconv"
+	"strings"
+)
+
+// maxErrorBodySize limita los bytes leídos del cuerpo de una respuesta de error.
+const maxErrorBodySize = 1 << 20
+
+// Error representa una respuesta de error de la API de Mercado Libre.
+// Todas las funciones del paquete lo devuelven ante un status >= 400; se obtiene con errors.As.
+type Error struct {
+	StatusCode int            `json:"-"`       // Status HTTP de la respuesta
+	Message    string         `json:"message"` // Mensaje legible (ej. "Item with id MLM1 not found")
+	Code       string         `json:"error"`   // Código de error (ej. "not_found", "validation_error")
+	Status     int            `json:"status"`  // Status reportado en el payload
+	Cause      []ErrorCause   `json:"cause"`   // Causas detalladas (validaciones, etc.)
+	RequestID  string         `json:"-"`       // ID de la petición (header X-Request-Id)
+	Header     nethttp.Header `json:"-"`       // Headers de la respuesta
+	Body       []byte         `json:"-"`       // Cuerpo crudo de la respuesta
+}
+
+// ErrorCause representa un elemento del arreglo cause de un error.
+// Algunos endpoints devuelven causas como strings; en ese caso solo se llena Message.
+type ErrorCause struct {
+	CauseID    int      `json:"cause_id"`   // ID numérico de la causa
+	Code       string   `json:"code"`       // Código (ej. "item.title.length.invalid")
+	Type       string   `json:"type"`       // Tipo: "error" o "warning"
+	Department string   `json:"department"` // Área que origina la causa
+	Message    string   `json:"message"`    // Mensaje descriptivo
+	References []string `json:"references"` // Campos referenciados (ej. "item.title")
+}
+
+// UnmarshalJSON acepta causas como objeto o como string.
+func (c *ErrorCause) UnmarshalJSON(data []byte) error {
+	var message string
+	if err := json.Unmarshal(data, &message); err == nil {
+		*c = ErrorCause{Message: message}
+		return nil
+	}
+	type plain ErrorCause
+	return json.Unmarshal(data, (*plain)(c))
+}
+
+// Error implementa la interfaz error.
+func (e *Error) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "status inesperado: %d", e.StatusCode)
+	if e.Code != "" {
+		fmt.Fprintf(&b, " (%s)", e.Code)
+	}
+	if e.Message != "" {
+		fmt.Fprintf(&b, ": %s", e.Message)
+	}
+	for _, cause := range e.Cause {
+		if cause.Code != "" {
+			fmt.Fprintf(&b, "; %s: %s", cause.Code, cause.Message)
+		} else {
+			fmt.Fprintf(&b, "; %s", cause.Message)
+		}
+	}
+	return b.String()
+}
+
+// newError construye un *Error leyendo y cerrando el cuerpo de resp.
+func newError(resp *nethttp.Response) *Error {
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+
+	apiErr := parseError(resp.StatusCode, body)
+	apiErr.RequestID = resp.Header.Get("X-Request-Id")
+	apiErr.Header = resp.Header
+	return apiErr
+}
+
+// parseError construye un *Error a partir de un status y un payload de error de MELI.
+// Se usa también para las entradas fallidas de respuestas multiget.
+func parseError(statusCode int, body []byte) *Error {
+	apiErr := &Error{
+		StatusCode: statusCode,
+		Header:     nethttp.Header{},
+		Body:       body,
+	}
+
+	var payload struct {
+		Message string          `json:"message"`
+		Code    string          `json:"error"`
+		Status  json.RawMessage `json:"status"`
+		Cause   []ErrorCause    `json:"cause"`
+	}
+	if json.Unmarshal(body, &payload) == nil {
+		apiErr.Message = payload.Message
+		apiErr.Code = payload.Code
+		apiErr.Cause = payload.Cause
+		// status llega como número o como string según el endpoint
+		apiErr.Status, _ = strconv.Atoi(string(bytes.Trim(payload.Status, `"`)))
+	} else {
+		apiErr.Message = strings.TrimSpace(string(body))
+	}
+	return apiErr
+}
+
+// hasStatus indica si err es un *Error con alguno de los status indicados.
+func hasStatus(err error, statuses ...int) bool {
+	var apiErr *Error
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	for _, status := range statuses {
+		if apiErr.StatusCode == status {
+			return true
+		}
+	}
+	return false
+}
+
+// IsNotFound indica si err corresponde a un 404.
+func IsNotFound(err error) bool {
+	return hasStatus(err, nethttp.StatusNotFound)
+}
+
+// IsUnauthorized indica si err corresponde a un 401 (token inválido o expirado).
+func IsUnauthorized(err error) bool {
+	return hasStatus(err, nethttp.StatusUnauthorized)
+}
+
+// IsForbidden indica si err corresponde a un 403 (sin permisos sobre el recurso).
+func IsForbidden(err error) bool {
+	return hasStatus(err, nethttp.StatusForbidden)
+}
+
+// IsBadRequest indica si err corresponde a un 400 (validación fallida).
+func IsBadRequest(err error) bool {
+	return hasStatus(err, nethttp.StatusBadRequest)
+}
+
+// IsRateLimited indica si err corresponde a un 429 (demasiadas peticiones).
+func IsRateLimited(err error) bool {
+	return hasStatus(err, nethttp.StatusTooManyRequests)
+}
+
+// IsServerError indica si err corresponde a un status 5xx.
+func IsServerError(err error) bool {
+	var apiErr *Error
+	return errors.As(err, &apiErr) && apiErr.StatusCode >= 500
+}
@@ -2,13 +2,18 @@ package api
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	nethttp "net/http"
+	"net/url"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/tidyrocks/mercado-libre-go-sdk/internal/http"
 )
 
-const itemsEndpoint = "https://api.mercadolibre.com/items"
+const itemsEndpoint = "/items"
 
 // Item representa una publicación individual visible en el sitio de Mercado Libre.
 type Item struct {
@@ -69,9 +74,283 @@ type ItemShipping struct {
 }
 
 // GetItem obtiene un ítem por ID usando la API de Mercado Libre.
+func (c *Client) GetItem(ctx context.Context, itemID, accessToken string) (Item, error) {
+	url := c.endpoint("%s/%s", itemsEndpoint, itemID)
+	var item Item
+	err := http.DoGetJSON(ctx, c, url, accessToken, &item)
+	return item, err
+}
+
+// GetItem obtiene un ítem por ID usando el Client por defecto.
 func GetItem(ctx context.Context, itemID, accessToken string) (Item, error) {
-	url := fmt.Sprintf("%s/%s", itemsEndpoint, itemID)
+	return defaultClient.GetItem(ctx, itemID, accessToken)
+}
+
+// ItemRequest representa el cuerpo para crear un ítem (POST /items).
+type ItemRequest struct {
+	Title             string             `json:"title"`                // Título del ítem
+	CategoryID        string             `json:"category_id"`          // ID de la categoría
+	Price             float64            `json:"price"`                // Precio
+	CurrencyID        string             `json:"currency_id"`          // Moneda (ej. MXN)
+	AvailableQuantity int                `json:"available_quantity"`   // Stock disponible
+	BuyingMode        string             `json:"buying_mode"`          // Modo de compra: "buy_it_now"
+	ListingTypeID     string             `json:"listing_type_id"`      // Tipo de publicación: "gold_special", etc.
+	Condition         string             `json:"condition"`            // Condición: "new", "used"
+	Pictures          []PictureRequest   `json:"pictures,omitempty"`   // Imágenes (por ID o URL)
+	Attrs             []AttrRequest      `json:"attributes,omitempty"` // Atributos
+	Variations        []VariationRequest `json:"variations,omitempty"` // Variaciones
+	Channels          []string           `json:"channels,omitempty"`   // Canales de venta
+	// Campos opcionales (punteros)
+	ShippingConfig    *ItemShipping       `json:"shipping,omitempty"`            // Configuración de envío
+	SellerCustomField *string             `json:"seller_custom_field,omitempty"` // SKU privado del vendedor
+	OfficialStoreID   *int64              `json:"official_store_id,omitempty"`   // ID de tienda oficial
+	CatalogProductID  *string             `json:"catalog_product_id,omitempty"`  // ID de producto de catálogo
+	CatalogListing    *bool               `json:"catalog_listing,omitempty"`     // Si es listing de catálogo
+	Description       *DescriptionRequest `json:"description,omitempty"`         // Descripción inicial
+}
+
+// ItemUpdateRequest representa una actualización parcial de un ítem (PUT /items/{id}).
+// Solo se envían los campos no nulos.
+type ItemUpdateRequest struct {
+	Title             *string            `json:"title,omitempty"`               // Nuevo título
+	Price             *float64           `json:"price,omitempty"`               // Nuevo precio
+	AvailableQuantity *int               `json:"available_quantity,omitempty"`  // Nuevo stock
+	Status            *string            `json:"status,omitempty"`              // "active", "paused", "closed"
+	Pictures          []PictureRequest   `json:"pictures,omitempty"`            // Reemplaza todas las imágenes
+	Attrs             []AttrRequest      `json:"attributes,omitempty"`          // Atributos a modificar
+	Variations        []VariationRequest `json:"variations,omitempty"`          // Variaciones (las omitidas se eliminan)
+	ShippingConfig    *ItemShipping      `json:"shipping,omitempty"`            // Configuración de envío
+	SellerCustomField *string            `json:"seller_custom_field,omitempty"` // SKU privado del vendedor
+}
+
+// RelistRequest representa el cuerpo para republicar un ítem cerrado (POST /items/{id}/relist).
+type RelistRequest struct {
+	Price         float64 `json:"price"`              // Precio de la nueva publicación
+	Quantity      int     `json:"quantity,omitempty"` // Stock (sin variaciones)
+	ListingTypeID string  `json:"listing_type_id"`    // Tipo de publicación
+	// Arrays y slices
+	Variations []RelistVariation `json:"variations,omitempty"` // Precio y stock por variación
+}
+
+// RelistVariation representa precio y stock de una variación al republicar.
+type RelistVariation struct {
+	ID       int64   `json:"id"`       // ID de la variación original
+	Price    float64 `json:"price"`    // Precio
+	Quantity int     `json:"quantity"` // Stock
+}
+
+// Estados de un ítem usados por los helpers de cambio de estado.
+const (
+	ItemStatusActive = "active"
+	ItemStatusPaused = "paused"
+	ItemStatusClosed = "closed"
+)
+
+// NewItemRequest arma un ItemRequest a partir de un Item existente (ej. para duplicar una publicación).
+func NewItemRequest(item Item) ItemRequest {
+	shipping := item.ShippingConfig
+	request := ItemRequest{
+		Title:             item.Title,
+		CategoryID:        item.CategoryID,
+		Price:             item.Price,
+		CurrencyID:        item.CurrencyID,
+		AvailableQuantity: item.AvailableQuantity,
+		BuyingMode:        item.BuyingMode,
+		ListingTypeID:     item.ListingTypeID,
+		Condition:         item.Condition,
+		Pictures:          NewPictureRequests(item.Pictures),
+		Attrs:             NewAttrRequests(item.Attrs),
+		Variations:        NewVariationRequests(item.Variations),
+		Channels:          item.Channels,
+		ShippingConfig:    &shipping,
+		SellerCustomField: item.SellerCustomField,
+		OfficialStoreID:   item.OfficialStoreID,
+		CatalogProductID:  item.CatalogProductID,
+	}
+	// Las variaciones de un ítem nuevo no llevan ID
+	for i := range request.Variations {
+		request.Variations[i].ID = nil
+	}
+	if item.CatalogListing {
+		request.CatalogListing = &item.CatalogListing
+	}
+	return request
+}
+
+// CreateItem publica un ítem nuevo.
+func (c *Client) CreateItem(ctx context.Context, request ItemRequest, accessToken string) (Item, error) {
+	var item Item
+	err := http.DoPostJSON(ctx, c, c.endpoint(itemsEndpoint), accessToken, request, &item)
+	return item, err
+}
+
+// CreateItem publica un ítem nuevo usando el Client por defecto.
+func CreateItem(ctx context.Context, request ItemRequest, accessToken string) (Item, error) {
+	return defaultClient.CreateItem(ctx, request, accessToken)
+}
+
+// UpdateItem modifica parcialmente un ítem (precio, stock, título, imágenes, atributos, variaciones).
+func (c *Client) UpdateItem(ctx context.Context, itemID string, update ItemUpdateRequest, accessToken string) (Item, error) {
+	url := c.endpoint("%s/%s", itemsEndpoint, itemID)
+	var item Item
+	err := http.DoPutJSON(ctx, c, url, accessToken, update, &item)
+	return item, err
+}
+
+// UpdateItem modifica parcialmente un ítem usando el Client por defecto.
+func UpdateItem(ctx context.Context, itemID string, update ItemUpdateRequest, accessToken string) (Item, error) {
+	return defaultClient.UpdateItem(ctx, itemID, update, accessToken)
+}
+
+// setItemStatus cambia el estado de un ítem.
+func (c *Client) setItemStatus(ctx context.Context, itemID, status, accessToken string) (Item, error) {
+	return c.UpdateItem(ctx, itemID, ItemUpdateRequest{Status: &status}, accessToken)
+}
+
+// PauseItem pausa una publicación activa.
+func (c *Client) PauseItem(ctx context.Context, itemID, accessToken string) (Item, error) {
+	return c.setItemStatus(ctx, itemID, ItemStatusPaused, accessToken)
+}
+
+// PauseItem pausa una publicación usando el Client por defecto.
+func PauseItem(ctx context.Context, itemID, accessToken string) (Item, error) {
+	return defaultClient.PauseItem(ctx, itemID, accessToken)
+}
+
+// ActivateItem reactiva una publicación pausada.
+func (c *Client) ActivateItem(ctx context.Context, itemID, accessToken string) (Item, error) {
+	return c.setItemStatus(ctx, itemID, ItemStatusActive, accessToken)
+}
+
+// ActivateItem reactiva una publicación usando el Client por defecto.
+func ActivateItem(ctx context.Context, itemID, accessToken string) (Item, error) {
+	return defaultClient.ActivateItem(ctx, itemID, accessToken)
+}
+
+// CloseItem finaliza una publicación. Un ítem cerrado solo puede republicarse con RelistItem.
+func (c *Client) CloseItem(ctx context.Context, itemID, accessToken string) (Item, error) {
+	return c.setItemStatus(ctx, itemID, ItemStatusClosed, accessToken)
+}
+
+// CloseItem finaliza una publicación usando el Client por defecto.
+func CloseItem(ctx context.Context, itemID, accessToken string) (Item, error) {
+	return defaultClient.CloseItem(ctx, itemID, accessToken)
+}
+
+// RelistItem republica un ítem cerrado y devuelve el ítem nuevo (con otro ID).
+func (c *Client) RelistItem(ctx context.Context, itemID string, request RelistRequest, accessToken string) (Item, error) {
+	url := c.endpoint("%s/%s/relist", itemsEndpoint, itemID)
 	var item Item
-	err := http.DoGetJSON(ctx, url, accessToken, &item)
+	err := http.DoPostJSON(ctx, c, url, accessToken, request, &item)
 	return item, err
 }
+
+// RelistItem republica un ítem cerrado usando el Client por defecto.
+func RelistItem(ctx context.Context, itemID string, request RelistRequest, accessToken string) (Item, error) {
+	return defaultClient.RelistItem(ctx, itemID, request, accessToken)
+}
+
+// multigetChunkSize es la cantidad máxima de IDs que acepta /items?ids=.
+const multigetChunkSize = 20
+
+// defaultMultigetConcurrency es la cantidad de lotes consultados en paralelo por defecto.
+const defaultMultigetConcurrency = 4
+
+// GetItemsOptions configura una consulta multiget.
+type GetItemsOptions struct {
+	Attributes  []string // Campos a devolver (ej. "id", "price"); vacío devuelve el ítem completo
+	Concurrency int      // Lotes de 20 IDs consultados en paralelo (default 4)
+}
+
+// ItemResult representa el resultado de un ID dentro de un multiget.
+type ItemResult struct {
+	ID   string // ID solicitado
+	Code int    // Código por entrada reportado por la API (200, 404, etc.; 0 si falló el lote)
+	Item Item   // Ítem (solo si Err es nil)
+	Err  error  // *Error de la entrada, o el error del lote completo
+}
+
+// multigetEntry representa una entrada de la respuesta de /items?ids= (uso interno)
+type multigetEntry struct {
+	Code int             `json:"code"`
+	Body json.RawMessage `json:"body"`
+}
+
+// GetItems obtiene varios ítems usando el endpoint multiget, en lotes de 20 IDs con concurrencia acotada.
+// Los resultados respetan el orden de ids. Los fallos por ID o por lote quedan en ItemResult.Err,
+// de modo que un fallo parcial no invalida el resto; el error retornado solo indica cancelación de ctx,
+// en cuyo caso los IDs sin consultar quedan con Err igual a ctx.Err().
+func (c *Client) GetItems(ctx context.Context, ids []string, opts GetItemsOptions, accessToken string) ([]ItemResult, error) {
+	results := make([]ItemResult, len(ids))
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = defaultMultigetConcurrency
+	}
+
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for start := 0; start < len(ids); start += multigetChunkSize {
+		end := min(start+multigetChunkSize, len(ids))
+
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			wg.Wait()
+			// Los lotes que no llegaron a enviarse quedan con el error de cancelación
+			for i := start; i < len(ids); i++ {
+				results[i] = ItemResult{ID: ids[i], Err: ctx.Err()}
+			}
+			return results, ctx.Err()
+		}
+		wg.Add(1)
+		go func(chunk []ItemResult, chunkIDs []string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			c.getItemsChunk(ctx, chunkIDs, opts.Attributes, accessToken, chunk)
+		}(results[start:end], ids[start:end])
+	}
+	wg.Wait()
+
+	return results, ctx.Err()
+}
+
+// GetItems obtiene varios ítems usando el Client por defecto.
+func GetItems(ctx context.Context, ids []string, opts GetItemsOptions, accessToken string) ([]ItemResult, error) {
+	return defaultClient.GetItems(ctx, ids, opts, accessToken)
+}
+
+// getItemsChunk consulta un lote de hasta 20 IDs y llena results (misma longitud que ids).
+func (c *Client) getItemsChunk(ctx context.Context, ids, attributes []string, accessToken string, results []ItemResult) {
+	for i, id := range ids {
+		results[i].ID = id
+	}
+
+	params := url.Values{}
+	params.Set("ids", strings.Join(ids, ","))
+	if len(attributes) > 0 {
+		params.Set("attributes", strings.Join(attributes, ","))
+	}
+
+	var entries []multigetEntry
+	if err := http.DoGetJSONWithParams(ctx, c, c.endpoint(itemsEndpoint), accessToken, params, &entries); err != nil {
+		for i := range results {
+			results[i].Err = err
+		}
+		return
+	}
+
+	// La API responde en el mismo orden que los IDs solicitados
+	for i := range results {
+		if i >= len(entries) {
+			results[i].Err = fmt.Errorf("multiget sin respuesta para %s", results[i].ID)
+			continue
+		}
+		entry := entries[i]
+		results[i].Code = entry.Code
+		if entry.Code != nethttp.StatusOK {
+			results[i].Err = parseError(entry.Code, entry.Body)
+			continue
+		}
+		results[i].Err = json.Unmarshal(entry.Body, &results[i].Item)
+	}
+}
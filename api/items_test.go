@@ -0,0 +1,134 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	nethttp "net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestGetItemsChunking(t *testing.T) {
+	var inFlight, maxInFlight atomic.Int32
+	var mu sync.Mutex
+	var chunkSizes []int
+	srv := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		current := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			previous := maxInFlight.Load()
+			if current <= previous || maxInFlight.CompareAndSwap(previous, current) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+
+		if got := r.URL.Query().Get("attributes"); got != "id,price" {
+			t.Errorf("attributes = %q", got)
+		}
+		ids := strings.Split(r.URL.Query().Get("ids"), ",")
+		mu.Lock()
+		chunkSizes = append(chunkSizes, len(ids))
+		mu.Unlock()
+
+		if slices.Contains(ids, "MLM-FAIL") {
+			w.WriteHeader(nethttp.StatusInternalServerError)
+			fmt.Fprint(w, `{"message":"boom"}`)
+			return
+		}
+		entries := make([]string, 0, len(ids))
+		for _, id := range ids {
+			if id == "MLM-404" {
+				entries = append(entries, `{"code":404,"body":{"message":"not found","error":"not_found","status":404}}`)
+				continue
+			}
+			entries = append(entries, fmt.Sprintf(`{"code":200,"body":{"id":%q}}`, id))
+		}
+		fmt.Fprintf(w, "[%s]", strings.Join(entries, ","))
+	}))
+	defer srv.Close()
+
+	ids := make([]string, 65)
+	for i := range ids {
+		ids[i] = fmt.Sprintf("MLM%d", i)
+	}
+	ids[7] = "MLM-404"
+	ids[50] = "MLM-FAIL" // Falla el tercer lote completo (IDs 40 a 59)
+
+	client := NewClient(WithBaseURL(srv.URL))
+	results, err := client.GetItems(context.Background(), ids, GetItemsOptions{Attributes: []string{"id", "price"}, Concurrency: 2}, "")
+	if err != nil {
+		t.Fatalf("GetItems: %v", err)
+	}
+
+	slices.Sort(chunkSizes)
+	if !slices.Equal(chunkSizes, []int{5, 20, 20, 20}) {
+		t.Errorf("lotes = %v, want [5 20 20 20]", chunkSizes)
+	}
+	if got := maxInFlight.Load(); got > 2 {
+		t.Errorf("lotes simultáneos = %d, want <= 2", got)
+	}
+
+	if len(results) != len(ids) {
+		t.Fatalf("resultados = %d, want %d", len(results), len(ids))
+	}
+	for i, result := range results {
+		if result.ID != ids[i] {
+			t.Errorf("resultado %d: ID = %s, want %s", i, result.ID, ids[i])
+		}
+		switch {
+		case i == 7:
+			if !IsNotFound(result.Err) || result.Code != 404 {
+				t.Errorf("MLM-404: Code %d, Err %v", result.Code, result.Err)
+			}
+		case i >= 40 && i < 60:
+			if !IsServerError(result.Err) || result.Code != 0 {
+				t.Errorf("resultado %d del lote fallido: Code %d, Err %v", i, result.Code, result.Err)
+			}
+		default:
+			if result.Err != nil || result.Item.ID != ids[i] {
+				t.Errorf("resultado %d: Item %q, Err %v", i, result.Item.ID, result.Err)
+			}
+		}
+	}
+}
+
+func TestGetItemsCanceledWhileWaiting(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	srv := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		// El primer lote cancela el contexto mientras los demás esperan turno
+		cancel()
+		ids := strings.Split(r.URL.Query().Get("ids"), ",")
+		entries := make([]string, 0, len(ids))
+		for _, id := range ids {
+			entries = append(entries, fmt.Sprintf(`{"code":200,"body":{"id":%q}}`, id))
+		}
+		fmt.Fprintf(w, "[%s]", strings.Join(entries, ","))
+	}))
+	defer srv.Close()
+
+	ids := make([]string, 45)
+	for i := range ids {
+		ids[i] = fmt.Sprintf("MLM%d", i)
+	}
+
+	client := NewClient(WithBaseURL(srv.URL))
+	results, err := client.GetItems(ctx, ids, GetItemsOptions{Concurrency: 1}, "")
+	if err != context.Canceled {
+		t.Fatalf("err = %v, want Canceled", err)
+	}
+	for i, result := range results {
+		if result.ID != ids[i] {
+			t.Errorf("resultado %d: ID = %q, want %s", i, result.ID, ids[i])
+		}
+		if i >= multigetChunkSize && result.Err == nil {
+			t.Errorf("resultado %d sin consultar: Err nil", i)
+		}
+	}
+}
@@ -0,0 +1,238 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"io"
+	"iter"
+	nethttp "net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tidyrocks/mercado-libre-go-sdk/internal/http"
+)
+
+const messagesEndpoint = "/messages"
+
+// defaultMessagesLimit es el tamaño de página usado por el iterador de mensajes.
+const defaultMessagesLimit = 50
+
+// Message representa un mensaje de postventa entre vendedor y comprador
+type Message struct {
+	ID          string            `json:"id"`                  // ID del mensaje
+	SiteID      string            `json:"site_id"`             // ID del sitio
+	ClientID    int64             `json:"client_id"`           // ID de la aplicación que lo envió
+	From        MessageUser       `json:"from"`                // Remitente
+	To          MessageUser       `json:"to"`                  // Destinatario
+	Status      string            `json:"status"`              // Estado: "available", "moderated", etc.
+	Text        string            `json:"text"`                // Texto
+	MessageDate MessageDates      `json:"message_date"`        // Fechas del mensaje
+	Moderation  MessageModeration `json:"message_moderation"`  // Resultado de moderación
+	Attachments []MessageAttach   `json:"message_attachments"` // Adjuntos
+}
+
+// MessageUser representa el remitente o destinatario de un mensaje
+type MessageUser struct {
+	UserID int64 `json:"user_id"` // ID del usuario
+}
+
+// MessageDates representa las fechas de un mensaje
+type MessageDates struct {
+	Created   *time.Time `json:"created,omitempty"`   // Creación
+	Received  *time.Time `json:"received,omitempty"`  // Recepción
+	Available *time.Time `json:"available,omitempty"` // Disponible tras moderación
+	Notified  *time.Time `json:"notified,omitempty"`  // Notificación al destinatario
+	Read      *time.Time `json:"read,omitempty"`      // Lectura
+}
+
+// MessageModeration representa el resultado de moderación de un mensaje
+type MessageModeration struct {
+	Status string `json:"status"` // Estado: "clean", "rejected", etc.
+	Reason string `json:"reason"` // Motivo del rechazo
+	Source string `json:"source"` // Origen de la moderación
+}
+
+// MessageAttach representa un adjunto de un mensaje
+type MessageAttach struct {
+	Filename         string `json:"filename"`          // Nombre interno (usado para descargar)
+	OriginalFilename string `json:"original_filename"` // Nombre original
+	Type             string `json:"type"`              // Tipo MIME
+	Size             int64  `json:"size"`              // Tamaño en bytes
+}
+
+// PackMessages representa una página de mensajes de un pack
+type PackMessages struct {
+	Messages           []Message          `json:"messages"`            // Mensajes
+	Paging             Paging             `json:"paging"`              // Paginación
+	ConversationStatus ConversationStatus `json:"conversation_status"` // Estado de la conversación
+}
+
+// ConversationStatus representa si la conversación admite mensajes libres
+type ConversationStatus struct {
+	Status string `json:"status"` // "active" o "blocked"
+	// Campos opcionales (punteros)
+	Substatus *string `json:"substatus,omitempty"` // Motivo del bloqueo (ej. "blocked_by_time")
+}
+
+// MessageRequest representa el cuerpo para enviar un mensaje
+type MessageRequest struct {
+	From        MessageUser `json:"from"`                  // Vendedor
+	To          MessageUser `json:"to"`                    // Comprador
+	Text        string      `json:"text"`                  // Texto (máximo 350 caracteres)
+	Attachments []string    `json:"attachments,omitempty"` // IDs devueltos por UploadMessageAttachment
+}
+
+// messageAttachmentResponse representa la respuesta al subir un adjunto (uso interno)
+type messageAttachmentResponse struct {
+	ID string `json:"id"` // ID del adjunto
+}
+
+// ActionGuide representa las opciones disponibles para escribir en una conversación restringida
+type ActionGuide struct {
+	Options []ActionGuideOption `json:"options"` // Opciones disponibles
+}
+
+// ActionGuideOption representa una opción de la guía de acciones
+type ActionGuideOption struct {
+	ID                  string   `json:"id"`                   // ID de la opción (ej. "REQUEST_VARIANTS")
+	InternalDescription string   `json:"internal_description"` // Descripción
+	ActionsLeft         int      `json:"actions_left"`         // Usos restantes
+	Capabilities        []string `json:"capabilities"`         // Capacidades (ej. "free_text")
+	// Campos opcionales (punteros)
+	TemplateID *string `json:"template_id,omitempty"` // Plantilla asociada
+}
+
+// actionGuideOptionRequest representa el cuerpo para usar una opción de la guía (uso interno)
+type actionGuideOptionRequest struct {
+	OptionID string `json:"option_id"`      // ID de la opción
+	Text     string `json:"text,omitempty"` // Texto libre (si la opción lo permite)
+}
+
+// packURL arma la URL de mensajes de un pack para un vendedor.
+func (c *Client) packURL(packID, sellerID int64) string {
+	return c.endpoint("%s/packs/%d/sellers/%d", messagesEndpoint, packID, sellerID)
+}
+
+// GetPackMessages obtiene una página de mensajes de un pack (mark_as_read=false para no marcarlos leídos)
+func (c *Client) GetPackMessages(ctx context.Context, packID, sellerID int64, limit, offset int, accessToken string) (PackMessages, error) {
+	params := url.Values{}
+	params.Set("tag", "post_sale")
+	params.Set("mark_as_read", "false")
+	if limit > 0 {
+		params.Set("limit", strconv.Itoa(limit))
+	}
+	if offset > 0 {
+		params.Set("offset", strconv.Itoa(offset))
+	}
+
+	var messages PackMessages
+	err := http.DoGetJSONWithParams(ctx, c, c.packURL(packID, sellerID), accessToken, params, &messages)
+	return messages, err
+}
+
+// GetPackMessages obtiene mensajes de un pack usando el Client por defecto
+func GetPackMessages(ctx context.Context, packID, sellerID int64, limit, offset int, accessToken string) (PackMessages, error) {
+	return defaultClient.GetPackMessages(ctx, packID, sellerID, limit, offset, accessToken)
+}
+
+// Messages itera todos los mensajes de un pack, paginando por offset.
+// La iteración termina en el primer error.
+func (c *Client) Messages(ctx context.Context, packID, sellerID int64, accessToken string) iter.Seq2[Message, error] {
+	return func(yield func(Message, error) bool) {
+		offset := 0
+		for {
+			page, err := c.GetPackMessages(ctx, packID, sellerID, defaultMessagesLimit, offset, accessToken)
+			if err != nil {
+				yield(Message{}, err)
+				return
+			}
+			for _, message := range page.Messages {
+				if !yield(message, nil) {
+					return
+				}
+			}
+			next, more := page.Paging.next(len(page.Messages))
+			if !more {
+				return
+			}
+			offset = next
+		}
+	}
+}
+
+// Messages itera los mensajes de un pack usando el Client por defecto
+func Messages(ctx context.Context, packID, sellerID int64, accessToken string) iter.Seq2[Message, error] {
+	return defaultClient.Messages(ctx, packID, sellerID, accessToken)
+}
+
+// UploadMessageAttachment sube un archivo para adjuntarlo a un mensaje y devuelve su ID
+func (c *Client) UploadMessageAttachment(ctx context.Context, siteID string, file io.Reader, filename, accessToken string) (string, error) {
+	url := c.endpoint("%s/attachments?tag=post_sale&site_id=%s", messagesEndpoint, siteID)
+	var attachment messageAttachmentResponse
+	err := http.DoMultipartUpload(ctx, c, url, accessToken, file, filename, &attachment)
+	return attachment.ID, err
+}
+
+// UploadMessageAttachment sube un adjunto usando el Client por defecto
+func UploadMessageAttachment(ctx context.Context, siteID string, file io.Reader, filename, accessToken string) (string, error) {
+	return defaultClient.UploadMessageAttachment(ctx, siteID, file, filename, accessToken)
+}
+
+// SendMessage envía un mensaje al comprador de un pack
+func (c *Client) SendMessage(ctx context.Context, packID, sellerID int64, request MessageRequest, accessToken string) (Message, error) {
+	if strings.TrimSpace(request.Text) == "" && len(request.Attachments) == 0 {
+		return Message{}, errors.New("el mensaje requiere texto o adjuntos")
+	}
+	url := c.packURL(packID, sellerID) + "?tag=post_sale"
+	var message Message
+	err := http.DoPostJSON(ctx, c, url, accessToken, request, &message)
+	return message, err
+}
+
+// SendMessage envía un mensaje usando el Client por defecto
+func SendMessage(ctx context.Context, packID, sellerID int64, request MessageRequest, accessToken string) (Message, error) {
+	return defaultClient.SendMessage(ctx, packID, sellerID, request, accessToken)
+}
+
+// MarkMessagesRead marca como leídos los mensajes indicados
+func (c *Client) MarkMessagesRead(ctx context.Context, messageIDs []string, accessToken string) error {
+	if len(messageIDs) == 0 {
+		return nil
+	}
+	url := c.endpoint("%s/mark_as_read/%s?tag=post_sale", messagesEndpoint, strings.Join(messageIDs, ","))
+	var response struct{}
+	return http.DoReaderJSON(ctx, c, nethttp.MethodPut, url, accessToken, "", nil, &response)
+}
+
+// MarkMessagesRead marca mensajes como leídos usando el Client por defecto
+func MarkMessagesRead(ctx context.Context, messageIDs []string, accessToken string) error {
+	return defaultClient.MarkMessagesRead(ctx, messageIDs, accessToken)
+}
+
+// GetActionGuide obtiene las opciones disponibles para escribir en una conversación bloqueada
+func (c *Client) GetActionGuide(ctx context.Context, packID int64, accessToken string) (ActionGuide, error) {
+	url := c.endpoint("%s/action_guide/packs/%d?tag=post_sale", messagesEndpoint, packID)
+	var guide ActionGuide
+	err := http.DoGetJSON(ctx, c, url, accessToken, &guide)
+	return guide, err
+}
+
+// GetActionGuide obtiene la guía de acciones usando el Client por defecto
+func GetActionGuide(ctx context.Context, packID int64, accessToken string) (ActionGuide, error) {
+	return defaultClient.GetActionGuide(ctx, packID, accessToken)
+}
+
+// SendActionGuideOption envía un mensaje usando una opción de la guía de acciones
+func (c *Client) SendActionGuideOption(ctx context.Context, packID int64, optionID, text, accessToken string) (Message, error) {
+	url := c.endpoint("%s/action_guide/packs/%d/option?tag=post_sale", messagesEndpoint, packID)
+	var message Message
+	err := http.DoPostJSON(ctx, c, url, accessToken, actionGuideOptionRequest{OptionID: optionID, Text: text}, &message)
+	return message, err
+}
+
+// SendActionGuideOption envía una opción de la guía usando el Client por defecto
+func SendActionGuideOption(ctx context.Context, packID int64, optionID, text, accessToken string) (Message, error) {
+	return defaultClient.SendActionGuideOption(ctx, packID, optionID, text, accessToken)
+}
@@ -0,0 +1,127 @@
+package api
+
+import (
+	"context"
+	"iter"
+	"net/url"
+	"strconv"
+
+	"github.com/tidyrocks/mercado-libre-go-sdk/internal/http"
+)
+
+const missedFeedsEndpoint = "/missed_feeds"
+
+// defaultMissedFeedsLimit es el tamaño de página usado por el iterador de notificaciones perdidas.
+const defaultMissedFeedsLimit = 50
+
+// MissedNotification representa una notificación que no pudo entregarse al webhook
+type MissedNotification struct {
+	Notification
+	Request  MissedRequest  `json:"request"`  // Petición enviada al webhook
+	Response MissedResponse `json:"response"` // Respuesta obtenida del webhook
+}
+
+// MissedRequest representa la petición que MELI intentó enviar al webhook
+type MissedRequest struct {
+	URL  string `json:"url"`  // URL del webhook
+	Data string `json:"data"` // Cuerpo enviado (JSON de la notificación)
+}
+
+// MissedResponse representa la respuesta del webhook al último intento
+type MissedResponse struct {
+	HTTPCode int `json:"http_code"` // Status HTTP devuelto (0 si no hubo respuesta)
+	// Campos opcionales (punteros)
+	ReqTime *int64 `json:"req_time,omitempty"` // Duración de la petición en milisegundos
+}
+
+// MissedFeedsFilters representa los filtros de /missed_feeds
+type MissedFeedsFilters struct {
+	AppID  int64  // ID de la aplicación (requerido)
+	Topic  string // Tópico (ej. TopicOrders); vacío para todos
+	Limit  int    // Tamaño de página
+	Offset int    // Desplazamiento
+}
+
+// MissedFeeds representa una página de notificaciones perdidas
+type MissedFeeds struct {
+	Messages []MissedNotification `json:"messages"` // Notificaciones
+	Paging   Paging               `json:"paging"`   // Paginación
+}
+
+// params convierte los filtros en query parameters.
+func (f MissedFeedsFilters) params() url.Values {
+	params := url.Values{}
+	params.Set("app_id", strconv.FormatInt(f.AppID, 10))
+	if f.Topic != "" {
+		params.Set("topic", f.Topic)
+	}
+	if f.Limit > 0 {
+		params.Set("limit", strconv.Itoa(f.Limit))
+	}
+	if f.Offset > 0 {
+		params.Set("offset", strconv.Itoa(f.Offset))
+	}
+	return params
+}
+
+// GetMissedFeeds obtiene una página de notificaciones que no pudieron entregarse
+func (c *Client) GetMissedFeeds(ctx context.Context, filters MissedFeedsFilters, accessToken string) (MissedFeeds, error) {
+	var feeds MissedFeeds
+	err := http.DoGetJSONWithParams(ctx, c, c.endpoint(missedFeedsEndpoint), accessToken, filters.params(), &feeds)
+	return feeds, err
+}
+
+// GetMissedFeeds obtiene notificaciones perdidas usando el Client por defecto
+func GetMissedFeeds(ctx context.Context, filters MissedFeedsFilters, accessToken string) (MissedFeeds, error) {
+	return defaultClient.GetMissedFeeds(ctx, filters, accessToken)
+}
+
+// MissedNotifications itera todas las notificaciones perdidas que cumplen filters, paginando por offset.
+// La iteración termina en el primer error.
+func (c *Client) MissedNotifications(ctx context.Context, filters MissedFeedsFilters, accessToken string) iter.Seq2[MissedNotification, error] {
+	return func(yield func(MissedNotification, error) bool) {
+		filters := filters
+		if filters.Limit <= 0 {
+			filters.Limit = defaultMissedFeedsLimit
+		}
+		for {
+			page, err := c.GetMissedFeeds(ctx, filters, accessToken)
+			if err != nil {
+				yield(MissedNotification{}, err)
+				return
+			}
+			for _, notification := range page.Messages {
+				if !yield(notification, nil) {
+					return
+				}
+			}
+			offset, more := page.Paging.next(len(page.Messages))
+			if !more {
+				return
+			}
+			filters.Offset = offset
+		}
+	}
+}
+
+// MissedNotifications itera las notificaciones perdidas usando el Client por defecto
+func MissedNotifications(ctx context.Context, filters MissedFeedsFilters, accessToken string) iter.Seq2[MissedNotification, error] {
+	return defaultClient.MissedNotifications(ctx, filters, accessToken)
+}
+
+// Replay despacha de forma síncrona, con los mismos handlers que el webhook, las notificaciones
+// de notifications (ej. Client.MissedNotifications). Los errores de los handlers se reportan a
+// OnError y no detienen el proceso; devuelve cuántas se despacharon y el error de la iteración.
+func (r *NotificationRouter) Replay(ctx context.Context, notifications iter.Seq2[MissedNotification, error]) (int, error) {
+	dispatched := 0
+	for missed, err := range notifications {
+		if err != nil {
+			return dispatched, err
+		}
+		if err := r.dispatchWithTimeout(ctx, missed.Notification); err != nil && r.onError != nil {
+			r.onError(missed.Notification, err)
+		}
+		dispatched++
+	}
+	return dispatched, nil
+}
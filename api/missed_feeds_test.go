@@ -0,0 +1,63 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	nethttp "net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+)
+
+func TestMissedFeedsFiltersParams(t *testing.T) {
+	if got := (MissedFeedsFilters{AppID: 99}).params().Encode(); got != "app_id=99" {
+		t.Errorf("params = %s, want app_id=99", got)
+	}
+	got := MissedFeedsFilters{AppID: 99, Topic: TopicOrders, Limit: 10, Offset: 20}.params().Encode()
+	if want := "app_id=99&limit=10&offset=20&topic=orders_v2"; got != want {
+		t.Errorf("params = %s, want %s", got, want)
+	}
+}
+
+func TestReplay(t *testing.T) {
+	srv := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		if got := r.URL.Query().Get("app_id"); got != "99" {
+			t.Errorf("app_id = %q, want 99", got)
+		}
+		fmt.Fprint(w, `{"messages":[
+			{"_id":"a","topic":"orders_v2","resource":"/orders/1"},
+			{"_id":"b","topic":"orders_v2","resource":"/orders/2"},
+			{"_id":"c","topic":"items","resource":"/items/MLM1"}
+		],"paging":{"total":3,"offset":0,"limit":50}}`)
+	}))
+	defer srv.Close()
+
+	router := NewNotificationRouter(nil)
+	var handled []string
+	router.Handle(TopicOrders, func(ctx context.Context, n Notification) error {
+		handled = append(handled, n.ID)
+		if n.ResourceID() == "2" {
+			return errors.New("falló")
+		}
+		return nil
+	})
+	var failed []string
+	router.OnError(func(n Notification, err error) { failed = append(failed, n.ID) })
+
+	client := NewClient(WithBaseURL(srv.URL))
+	dispatched, err := router.Replay(context.Background(), client.MissedNotifications(context.Background(), MissedFeedsFilters{AppID: 99}, ""))
+	if err != nil {
+		t.Fatalf("Replay: %v", err)
+	}
+	// Las notificaciones sin handler también cuentan como despachadas (se ignoran)
+	if dispatched != 3 {
+		t.Errorf("despachadas = %d, want 3", dispatched)
+	}
+	if !slices.Equal(handled, []string{"a", "b"}) {
+		t.Errorf("procesadas = %v, want [a b]", handled)
+	}
+	if !slices.Equal(failed, []string{"b"}) {
+		t.Errorf("OnError = %v, want [b]", failed)
+	}
+}
@@ -0,0 +1,252 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	nethttp "net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Tópicos de notificaciones de Mercado Libre.
+const (
+	TopicItems          = "items"
+	TopicOrders         = "orders_v2"
+	TopicQuestions      = "questions"
+	TopicShipments      = "shipments"
+	TopicMessages       = "messages"
+	TopicItemsPrices    = "items_prices"
+	TopicStockLocations = "stock-locations"
+)
+
+// maxNotificationSize limita el tamaño del cuerpo aceptado por el webhook.
+const maxNotificationSize = 64 << 10
+
+// defaultNotificationTimeout es el tiempo máximo para procesar una notificación recibida por webhook.
+const defaultNotificationTimeout = 2 * time.Minute
+
+// defaultNotificationConcurrency es la cantidad máxima de notificaciones procesándose a la vez.
+const defaultNotificationConcurrency = 64
+
+// Notification representa el sobre de una notificación enviada por Mercado Libre
+type Notification struct {
+	ID            string    `json:"_id"`            // ID de la notificación
+	Resource      string    `json:"resource"`       // Recurso afectado (ej. "/items/MLM123")
+	UserID        int64     `json:"user_id"`        // Vendedor dueño del recurso
+	Topic         string    `json:"topic"`          // Tópico (ej. "items", "orders_v2")
+	ApplicationID int64     `json:"application_id"` // Aplicación destinataria
+	Attempts      int       `json:"attempts"`       // Número de intento de entrega
+	Sent          time.Time `json:"sent"`           // Fecha de envío
+	Received      time.Time `json:"received"`       // Fecha de recepción en MELI
+	// Arrays y slices
+	Actions []string `json:"actions,omitempty"` // Acciones (algunos tópicos)
+}
+
+// ResourceID devuelve el ID del recurso según el tópico: el último segmento de Resource
+// (ej. "/items/MLM123" -> "MLM123") o, en items_prices y stock-locations, el anterior
+// ("/items/MLM123/prices" -> "MLM123", "/user-products/MLMU1/stock" -> "MLMU1").
+func (n Notification) ResourceID() string {
+	resource, _, _ := strings.Cut(n.Resource, "?")
+	segments := strings.Split(strings.Trim(resource, "/"), "/")
+	index := len(segments) - 1
+	switch n.Topic {
+	case TopicItemsPrices, TopicStockLocations:
+		index = max(index-1, 0)
+	}
+	return segments[index]
+}
+
+// NotificationHandler procesa una notificación
+type NotificationHandler func(ctx context.Context, n Notification) error
+
+// NotificationRouter recibe notificaciones, las despacha al handler de su tópico y, para los
+// handlers tipados, obtiene el recurso referido antes de invocarlos. Hay handlers tipados para
+// items, orders_v2, questions y shipments; los demás tópicos (messages, items_prices,
+// stock-locations) se registran con Handle y usan Notification.ResourceID.
+// Implementa http.Handler: responde 200 de inmediato y procesa en segundo plano.
+type NotificationRouter struct {
+	clients  func(userID int64) *Client
+	handlers map[string]NotificationHandler
+	fallback NotificationHandler
+	onError  func(Notification, error)
+	timeout  time.Duration
+	slots    chan struct{} // Semáforo de notificaciones en proceso
+	wg       sync.WaitGroup
+}
+
+// NewNotificationRouter crea un router. clients devuelve el Client con el que se obtienen los
+// recursos de cada vendedor (ej. TokenVault.Client); si es nil se usa el Client por defecto.
+func NewNotificationRouter(clients func(userID int64) *Client) *NotificationRouter {
+	if clients == nil {
+		clients = func(int64) *Client { return defaultClient }
+	}
+	return &NotificationRouter{
+		clients:  clients,
+		handlers: make(map[string]NotificationHandler),
+		timeout:  defaultNotificationTimeout,
+		slots:    make(chan struct{}, defaultNotificationConcurrency),
+	}
+}
+
+// Handle registra handler para topic.
+func (r *NotificationRouter) Handle(topic string, handler NotificationHandler) {
+	r.handlers[topic] = handler
+}
+
+// HandleDefault registra el handler para tópicos sin handler propio.
+func (r *NotificationRouter) HandleDefault(handler NotificationHandler) {
+	r.fallback = handler
+}
+
+// OnError registra la función que recibe los errores de los handlers ejecutados en segundo plano.
+func (r *NotificationRouter) OnError(fn func(Notification, error)) {
+	r.onError = fn
+}
+
+// SetTimeout cambia el tiempo máximo de procesamiento de cada notificación recibida por webhook.
+func (r *NotificationRouter) SetTimeout(timeout time.Duration) {
+	r.timeout = timeout
+}
+
+// SetConcurrency cambia la cantidad máxima de notificaciones procesándose a la vez (por defecto 64;
+// n <= 0 restaura el valor por defecto). Debe llamarse antes de empezar a recibir notificaciones.
+func (r *NotificationRouter) SetConcurrency(n int) {
+	if n <= 0 {
+		n = defaultNotificationConcurrency
+	}
+	r.slots = make(chan struct{}, n)
+}
+
+// HandleItems registra un handler para el tópico items que recibe el ítem actualizado.
+func (r *NotificationRouter) HandleItems(handler func(ctx context.Context, n Notification, item Item) error) {
+	r.Handle(TopicItems, func(ctx context.Context, n Notification) error {
+		item, err := r.clients(n.UserID).GetItem(ctx, n.ResourceID(), "")
+		if err != nil {
+			return err
+		}
+		return handler(ctx, n, item)
+	})
+}
+
+// HandleOrders registra un handler para el tópico orders_v2 que recibe la orden actualizada.
+func (r *NotificationRouter) HandleOrders(handler func(ctx context.Context, n Notification, order Order) error) {
+	r.Handle(TopicOrders, func(ctx context.Context, n Notification) error {
+		orderID, err := strconv.ParseInt(n.ResourceID(), 10, 64)
+		if err != nil {
+			return fmt.Errorf("recurso de orden inválido %q: %w", n.Resource, err)
+		}
+		order, err := r.clients(n.UserID).GetOrder(ctx, orderID, "")
+		if err != nil {
+			return err
+		}
+		return handler(ctx, n, order)
+	})
+}
+
+// HandleQuestions registra un handler para el tópico questions que recibe la pregunta.
+func (r *NotificationRouter) HandleQuestions(handler func(ctx context.Context, n Notification, question Question) error) {
+	r.Handle(TopicQuestions, func(ctx context.Context, n Notification) error {
+		questionID, err := strconv.ParseInt(n.ResourceID(), 10, 64)
+		if err != nil {
+			return fmt.Errorf("recurso de pregunta inválido %q: %w", n.Resource, err)
+		}
+		question, err := r.clients(n.UserID).GetQuestion(ctx, questionID, "")
+		if err != nil {
+			return err
+		}
+		return handler(ctx, n, question)
+	})
+}
+
+// HandleShipments registra un handler para el tópico shipments que recibe el envío.
+func (r *NotificationRouter) HandleShipments(handler func(ctx context.Context, n Notification, shipment Shipment) error) {
+	r.Handle(TopicShipments, func(ctx context.Context, n Notification) error {
+		shipmentID, err := strconv.ParseInt(n.ResourceID(), 10, 64)
+		if err != nil {
+			return fmt.Errorf("recurso de envío inválido %q: %w", n.Resource, err)
+		}
+		shipment, err := r.clients(n.UserID).GetShipment(ctx, shipmentID, "")
+		if err != nil {
+			return err
+		}
+		return handler(ctx, n, shipment)
+	})
+}
+
+// Dispatch procesa n de forma síncrona con el handler de su tópico.
+// Los tópicos sin handler se ignoran salvo que exista un handler por defecto.
+func (r *NotificationRouter) Dispatch(ctx context.Context, n Notification) error {
+	handler, ok := r.handlers[n.Topic]
+	if !ok {
+		handler = r.fallback
+	}
+	if handler == nil {
+		return nil
+	}
+	return handler(ctx, n)
+}
+
+// dispatchWithTimeout despacha n limitando su procesamiento al timeout del router.
+// Un panic del handler se recupera y se devuelve como error.
+func (r *NotificationRouter) dispatchWithTimeout(ctx context.Context, n Notification) (err error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+	defer func() {
+		if p := recover(); p != nil {
+			err = fmt.Errorf("panic en el handler de %s %s: %v", n.Topic, n.Resource, p)
+		}
+	}()
+	return r.Dispatch(ctx, n)
+}
+
+// ServeHTTP decodifica la notificación, responde 200 de inmediato y la despacha en segundo plano.
+// Si ya hay SetConcurrency notificaciones en proceso responde 503 sin aceptarla, para que
+// Mercado Libre la reenvíe más tarde en lugar de acumular goroutines.
+func (r *NotificationRouter) ServeHTTP(w nethttp.ResponseWriter, req *nethttp.Request) {
+	if req.Method != nethttp.MethodPost {
+		nethttp.Error(w, "método no permitido", nethttp.StatusMethodNotAllowed)
+		return
+	}
+
+	var n Notification
+	if err := json.NewDecoder(io.LimitReader(req.Body, maxNotificationSize)).Decode(&n); err != nil {
+		nethttp.Error(w, "notificación inválida", nethttp.StatusBadRequest)
+		return
+	}
+
+	select {
+	case r.slots <- struct{}{}:
+	default:
+		nethttp.Error(w, "demasiadas notificaciones en proceso", nethttp.StatusServiceUnavailable)
+		return
+	}
+	w.WriteHeader(nethttp.StatusOK)
+
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		defer func() { <-r.slots }()
+		if err := r.dispatchWithTimeout(context.Background(), n); err != nil && r.onError != nil {
+			r.onError(n, err)
+		}
+	}()
+}
+
+// Wait bloquea hasta que terminen las notificaciones en proceso o ctx se cancele (para apagado ordenado).
+func (r *NotificationRouter) Wait(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		r.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,95 @@
+package api
+
+import (
+	"context"
+	nethttp "net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func postNotification(router *NotificationRouter, topic string) int {
+	req := httptest.NewRequest(nethttp.MethodPost, "/notifications",
+		strings.NewReader(`{"resource":"/items/MLM1","user_id":1,"topic":"`+topic+`"}`))
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	return rec.Code
+}
+
+func TestNotificationRouterRecoversPanic(t *testing.T) {
+	router := NewNotificationRouter(nil)
+	router.Handle(TopicItems, func(ctx context.Context, n Notification) error {
+		panic("boom")
+	})
+	var mu sync.Mutex
+	var errs []error
+	router.OnError(func(n Notification, err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		errs = append(errs, err)
+	})
+
+	if code := postNotification(router, TopicItems); code != nethttp.StatusOK {
+		t.Fatalf("status = %d, want 200", code)
+	}
+	if err := router.Wait(context.Background()); err != nil {
+		t.Fatalf("Wait: %v", err)
+	}
+	if len(errs) != 1 || !strings.Contains(errs[0].Error(), "boom") {
+		t.Errorf("errores = %v, want el panic", errs)
+	}
+}
+
+func TestNotificationRouterConcurrencyLimit(t *testing.T) {
+	router := NewNotificationRouter(nil)
+	router.SetConcurrency(2)
+	release := make(chan struct{})
+	router.Handle(TopicItems, func(ctx context.Context, n Notification) error {
+		<-release
+		return nil
+	})
+
+	codes := make([]int, 3)
+	for i := range codes {
+		codes[i] = postNotification(router, TopicItems)
+	}
+	want := []int{nethttp.StatusOK, nethttp.StatusOK, nethttp.StatusServiceUnavailable}
+	for i := range codes {
+		if codes[i] != want[i] {
+			t.Errorf("notificación %d: status %d, want %d", i, codes[i], want[i])
+		}
+	}
+
+	close(release)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err := router.Wait(ctx); err != nil {
+		t.Fatalf("Wait: %v", err)
+	}
+	// Al liberarse los lugares se vuelven a aceptar notificaciones
+	if code := postNotification(router, TopicItems); code != nethttp.StatusOK {
+		t.Errorf("status tras liberar = %d, want 200", code)
+	}
+	router.Wait(ctx)
+}
+
+func TestNotificationResourceID(t *testing.T) {
+	tests := []struct {
+		topic, resource, want string
+	}{
+		{TopicItems, "/items/MLA1", "MLA1"},
+		{TopicOrders, "/orders/2000001?x=1", "2000001"},
+		{TopicMessages, "/messages/abc123", "abc123"},
+		{TopicItemsPrices, "/items/MLA1/prices", "MLA1"},
+		{TopicStockLocations, "/user-products/MLAU1/stock", "MLAU1"},
+		{TopicItems, "MLA1", "MLA1"},
+	}
+	for _, tt := range tests {
+		n := Notification{Topic: tt.topic, Resource: tt.resource}
+		if got := n.ResourceID(); got != tt.want {
+			t.Errorf("%s %s: ResourceID = %q, want %q", tt.topic, tt.resource, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,178 @@
+package api
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net"
+	nethttp "net/http"
+	"net/url"
+	"time"
+
+	"github.com/tidyrocks/mercado-libre-go-sdk/internal/http"
+)
+
+// authorizationHosts mapea cada sitio a su dominio de autorización.
+var authorizationHosts = map[string]string{
+	"MLA": "auth.mercadolibre.com.ar", // Argentina
+	"MLB": "auth.mercadolivre.com.br", // Brasil
+	"MLC": "auth.mercadolibre.cl",     // Chile
+	"MCO": "auth.mercadolibre.com.co", // Colombia
+	"MCR": "auth.mercadolibre.co.cr",  // Costa Rica
+	"MEC": "auth.mercadolibre.com.ec", // Ecuador
+	"MLM": "auth.mercadolibre.com.mx", // México
+	"MPE": "auth.mercadolibre.com.pe", // Perú
+	"MLU": "auth.mercadolibre.com.uy", // Uruguay
+	"MLV": "auth.mercadolibre.com.ve", // Venezuela
+}
+
+// PKCE contiene el par verifier/challenge de Proof Key for Code Exchange (RFC 7636).
+type PKCE struct {
+	Verifier        string // Se guarda localmente y se envía en ExchangeCode
+	Challenge       string // Se envía en la URL de autorización
+	ChallengeMethod string // Siempre "S256"
+}
+
+// authorizationCodeRequest representa el intercambio de code por token (uso interno)
+type authorizationCodeRequest struct {
+	GrantType    string `json:"grant_type"`              // "authorization_code"
+	ClientID     string `json:"client_id"`               // ID de la aplicación
+	ClientSecret string `json:"client_secret"`           // Secret de la aplicación
+	Code         string `json:"code"`                    // Code recibido en el redirect
+	RedirectURI  string `json:"redirect_uri"`            // Debe coincidir con el de la URL de autorización
+	CodeVerifier string `json:"code_verifier,omitempty"` // Verifier PKCE (si se usó)
+}
+
+// randomString genera n bytes aleatorios codificados en base64url sin padding.
+func randomString(n int) (string, error) {
+	buf := make([]byte, n)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// NewState genera un valor state aleatorio para proteger el flujo contra CSRF.
+func NewState() (string, error) {
+	return randomString(24)
+}
+
+// NewPKCE genera un verifier aleatorio y su challenge S256.
+func NewPKCE() (PKCE, error) {
+	verifier, err := randomString(32)
+	if err != nil {
+		return PKCE{}, err
+	}
+	sum := sha256.Sum256([]byte(verifier))
+	return PKCE{
+		Verifier:        verifier,
+		Challenge:       base64.RawURLEncoding.EncodeToString(sum[:]),
+		ChallengeMethod: "S256",
+	}, nil
+}
+
+// AuthorizationURL arma la URL a la que se redirige al vendedor para autorizar la aplicación.
+// pkce es opcional (nil si la aplicación no usa PKCE).
+func AuthorizationURL(siteID, clientID, redirectURI, state string, pkce *PKCE) (string, error) {
+	host, ok := authorizationHosts[siteID]
+	if !ok {
+		return "", fmt.Errorf("sitio sin dominio de autorización: %s", siteID)
+	}
+
+	params := url.Values{}
+	params.Set("response_type", "code")
+	params.Set("client_id", clientID)
+	params.Set("redirect_uri", redirectURI)
+	if state != "" {
+		params.Set("state", state)
+	}
+	if pkce != nil {
+		params.Set("code_challenge", pkce.Challenge)
+		params.Set("code_challenge_method", pkce.ChallengeMethod)
+	}
+
+	u := url.URL{Scheme: "https", Host: host, Path: "/authorization", RawQuery: params.Encode()}
+	return u.String(), nil
+}
+
+// ExchangeCode intercambia el code recibido en el redirect por un Token (grant authorization_code).
+// codeVerifier es el PKCE.Verifier usado al armar la URL, o "" si no se usó PKCE.
+func (c *Client) ExchangeCode(ctx context.Context, clientID, clientSecret, code, redirectURI, codeVerifier string) (Token, error) {
+	request := authorizationCodeRequest{
+		GrantType:    "authorization_code",
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		Code:         code,
+		RedirectURI:  redirectURI,
+		CodeVerifier: codeVerifier,
+	}
+
+	var token Token
+	err := http.DoPostJSON(anonymous(ctx), c, c.endpoint(tokenEndpoint), "", request, &token)
+	return token.withExpiry(), err
+}
+
+// ExchangeCode intercambia un code por un Token usando el Client por defecto
+func ExchangeCode(ctx context.Context, clientID, clientSecret, code, redirectURI, codeVerifier string) (Token, error) {
+	return defaultClient.ExchangeCode(ctx, clientID, clientSecret, code, redirectURI, codeVerifier)
+}
+
+// CallbackHandler devuelve un handler para el redirect_uri que valida state y entrega
+// el code (o el error de autorización) a done. Pensado para onboarding desde CLI.
+func CallbackHandler(state string, done func(code string, err error)) nethttp.Handler {
+	return nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		query := r.URL.Query()
+		switch {
+		case query.Get("error") != "":
+			done("", fmt.Errorf("autorización rechazada: %s %s", query.Get("error"), query.Get("error_description")))
+			nethttp.Error(w, "Autorización rechazada", nethttp.StatusBadRequest)
+		case query.Get("state") != state:
+			done("", errors.New("state inválido en el callback de autorización"))
+			nethttp.Error(w, "State inválido", nethttp.StatusBadRequest)
+		case query.Get("code") == "":
+			done("", errors.New("callback de autorización sin code"))
+			nethttp.Error(w, "Falta code", nethttp.StatusBadRequest)
+		default:
+			done(query.Get("code"), nil)
+			fmt.Fprintln(w, "Autorización completada, puedes cerrar esta ventana.")
+		}
+	})
+}
+
+// WaitForCode levanta un servidor local en addr (ej. "localhost:8080"), espera un único
+// callback de autorización y devuelve el code recibido.
+func WaitForCode(ctx context.Context, addr, state string) (string, error) {
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return "", err
+	}
+
+	type result struct {
+		code string
+		err  error
+	}
+	results := make(chan result, 1)
+	server := &nethttp.Server{Handler: CallbackHandler(state, func(code string, err error) {
+		select {
+		case results <- result{code, err}:
+		default:
+		}
+	})}
+	go server.Serve(listener)
+	defer func() {
+		// Shutdown deja terminar la respuesta al navegador antes de cerrar
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		server.Shutdown(shutdownCtx)
+	}()
+
+	select {
+	case res := <-results:
+		return res.code, res.err
+	case <-ctx.Done():
+		return "", ctx.Err()
+	}
+}
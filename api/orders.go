@@ -0,0 +1,261 @@
+package api
+
+import (
+	"context"
+	"iter"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/tidyrocks/mercado-libre-go-sdk/internal/http"
+)
+
+const ordersEndpoint = "/orders"
+
+// defaultOrdersLimit es el tamaño de página usado por el iterador de órdenes.
+const defaultOrdersLimit = 50
+
+// orderDateLayout es el formato de fechas aceptado por los filtros de /orders/search.
+const orderDateLayout = "2006-01-02T15:04:05.000-07:00"
+
+// Order representa una orden de venta en Mercado Libre
+type Order struct {
+	ID          int64       `json:"id"`           // ID de la orden
+	Status      string      `json:"status"`       // Estado: "confirmed", "payment_required", "paid", "cancelled", etc.
+	DateCreated time.Time   `json:"date_created"` // Fecha de creación
+	LastUpdated time.Time   `json:"last_updated"` // Fecha de última actualización
+	TotalAmount float64     `json:"total_amount"` // Total de los ítems (sin envío)
+	PaidAmount  float64     `json:"paid_amount"`  // Total pagado (incluye envío)
+	CurrencyID  string      `json:"currency_id"`  // Moneda (ej. MXN)
+	Buyer       Buyer       `json:"buyer"`        // Comprador
+	Seller      OrderSeller `json:"seller"`       // Vendedor
+	Shipping    OrderShip   `json:"shipping"`     // Referencia al envío
+	// Arrays y slices
+	OrderItems []OrderItem `json:"order_items"` // Ítems comprados
+	Payments   []Payment   `json:"payments"`    // Pagos de la orden
+	Tags       []string    `json:"tags"`        // Tags ("paid", "delivered", "pack_order", etc.)
+	// Campos opcionales (punteros)
+	DateClosed   *time.Time   `json:"date_closed,omitempty"`   // Fecha de confirmación
+	PackID       *int64       `json:"pack_id,omitempty"`       // ID del pack (carrito con varias órdenes)
+	StatusDetail *OrderDetail `json:"status_detail,omitempty"` // Detalle del estado
+	Fulfilled    *bool        `json:"fulfilled,omitempty"`     // Si la orden fue entregada
+}
+
+// OrderItem representa un ítem comprado dentro de una orden
+type OrderItem struct {
+	Item          OrderItemRef `json:"item"`            // Referencia al ítem publicado
+	Quantity      int          `json:"quantity"`        // Cantidad comprada
+	UnitPrice     float64      `json:"unit_price"`      // Precio unitario cobrado
+	FullUnitPrice float64      `json:"full_unit_price"` // Precio unitario sin descuentos
+	CurrencyID    string       `json:"currency_id"`     // Moneda
+	SaleFee       float64      `json:"sale_fee"`        // Comisión de venta
+	ListingTypeID string       `json:"listing_type_id"` // Tipo de publicación
+}
+
+// OrderItemRef representa los datos del ítem copiados en la orden al momento de la compra
+type OrderItemRef struct {
+	ID         string `json:"id"`          // ID del ítem (ej. MLM123456)
+	Title      string `json:"title"`       // Título al momento de la compra
+	CategoryID string `json:"category_id"` // ID de la categoría
+	Condition  string `json:"condition"`   // Condición del ítem
+	// Arrays y slices
+	VariationAttrs []Attr `json:"variation_attributes"` // Atributos de la variación comprada
+	// Campos opcionales (punteros)
+	VariationID       *int64  `json:"variation_id,omitempty"`        // ID de la variación comprada
+	SellerCustomField *string `json:"seller_custom_field,omitempty"` // SKU privado del vendedor
+	SellerSKU         *string `json:"seller_sku,omitempty"`          // SKU (atributo SELLER_SKU)
+}
+
+// Buyer representa al comprador de una orden
+type Buyer struct {
+	ID       int64  `json:"id"`       // ID del comprador
+	Nickname string `json:"nickname"` // Apodo
+	// Campos opcionales (punteros)
+	FirstName *string `json:"first_name,omitempty"` // Nombre
+	LastName  *string `json:"last_name,omitempty"`  // Apellido
+}
+
+// OrderSeller representa al vendedor de una orden
+type OrderSeller struct {
+	ID       int64  `json:"id"`       // ID del vendedor
+	Nickname string `json:"nickname"` // Apodo
+}
+
+// OrderShip representa la referencia al envío de una orden
+type OrderShip struct {
+	ID *int64 `json:"id"` // ID del envío (nil si no tiene envío)
+}
+
+// OrderDetail representa el detalle del estado de una orden
+type OrderDetail struct {
+	Code        string `json:"code"`        // Código del detalle
+	Description string `json:"description"` // Descripción
+}
+
+// Payment representa un pago asociado a una orden
+type Payment struct {
+	ID                int64   `json:"id"`                 // ID del pago
+	OrderID           int64   `json:"order_id"`           // ID de la orden
+	PayerID           int64   `json:"payer_id"`           // ID del pagador
+	Status            string  `json:"status"`             // Estado: "approved", "pending", "rejected", "refunded", etc.
+	StatusDetail      string  `json:"status_detail"`      // Detalle del estado
+	TransactionAmount float64 `json:"transaction_amount"` // Monto de los ítems
+	ShippingCost      float64 `json:"shipping_cost"`      // Costo de envío
+	TotalPaidAmount   float64 `json:"total_paid_amount"`  // Total pagado
+	CurrencyID        string  `json:"currency_id"`        // Moneda
+	PaymentMethodID   string  `json:"payment_method_id"`  // Medio de pago (ej. "visa")
+	PaymentType       string  `json:"payment_type"`       // Tipo: "credit_card", "account_money", etc.
+	Installments      int     `json:"installments"`       // Cuotas
+	// Campos opcionales (punteros)
+	DateCreated  *time.Time `json:"date_created,omitempty"`  // Fecha de creación
+	DateApproved *time.Time `json:"date_approved,omitempty"` // Fecha de aprobación
+}
+
+// OrderFilters representa los filtros de búsqueda de órdenes
+type OrderFilters struct {
+	SellerID int64     // ID del vendedor (requerido si no se indica BuyerID)
+	BuyerID  int64     // ID del comprador
+	Status   string    // Estado de la orden (order.status)
+	DateFrom time.Time // Creada desde (order.date_created.from)
+	DateTo   time.Time // Creada hasta (order.date_created.to)
+	Query    string    // Búsqueda por ID de orden o ítem (q)
+	Sort     string    // Orden: "date_asc" o "date_desc"
+	Limit    int       // Tamaño de página (máximo 51)
+	Offset   int       // Desplazamiento
+}
+
+// OrdersSearch representa una página de resultados de /orders/search
+type OrdersSearch struct {
+	Results []Order `json:"results"` // Órdenes
+	Paging  Paging  `json:"paging"`  // Paginación
+}
+
+// LinkedOrderItem asocia un OrderItem con el Item publicado y la variación comprada
+type LinkedOrderItem struct {
+	OrderItem
+	Listing   Item       // Ítem actual (vacío si Err no es nil)
+	Variation *Variation // Variación comprada (nil si la compra no fue de una variación o ya no existe)
+	Err       error      // Error al obtener el ítem
+}
+
+// params convierte los filtros en query parameters.
+func (f OrderFilters) params() url.Values {
+	params := url.Values{}
+	if f.SellerID != 0 {
+		params.Set("seller", strconv.FormatInt(f.SellerID, 10))
+	}
+	if f.BuyerID != 0 {
+		params.Set("buyer", strconv.FormatInt(f.BuyerID, 10))
+	}
+	if f.Status != "" {
+		params.Set("order.status", f.Status)
+	}
+	if !f.DateFrom.IsZero() {
+		params.Set("order.date_created.from", f.DateFrom.Format(orderDateLayout))
+	}
+	if !f.DateTo.IsZero() {
+		params.Set("order.date_created.to", f.DateTo.Format(orderDateLayout))
+	}
+	if f.Query != "" {
+		params.Set("q", f.Query)
+	}
+	if f.Sort != "" {
+		params.Set("sort", f.Sort)
+	}
+	if f.Limit > 0 {
+		params.Set("limit", strconv.Itoa(f.Limit))
+	}
+	if f.Offset > 0 {
+		params.Set("offset", strconv.Itoa(f.Offset))
+	}
+	return params
+}
+
+// GetOrder obtiene una orden por su ID
+func (c *Client) GetOrder(ctx context.Context, orderID int64, accessToken string) (Order, error) {
+	url := c.endpoint("%s/%d", ordersEndpoint, orderID)
+	var order Order
+	err := http.DoGetJSON(ctx, c, url, accessToken, &order)
+	return order, err
+}
+
+// GetOrder obtiene una orden usando el Client por defecto
+func GetOrder(ctx context.Context, orderID int64, accessToken string) (Order, error) {
+	return defaultClient.GetOrder(ctx, orderID, accessToken)
+}
+
+// SearchOrders obtiene una página de órdenes que cumplen filters
+func (c *Client) SearchOrders(ctx context.Context, filters OrderFilters, accessToken string) (OrdersSearch, error) {
+	url := c.endpoint("%s/search", ordersEndpoint)
+	var search OrdersSearch
+	err := http.DoGetJSONWithParams(ctx, c, url, accessToken, filters.params(), &search)
+	return search, err
+}
+
+// SearchOrders obtiene una página de órdenes usando el Client por defecto
+func SearchOrders(ctx context.Context, filters OrderFilters, accessToken string) (OrdersSearch, error) {
+	return defaultClient.SearchOrders(ctx, filters, accessToken)
+}
+
+// Orders itera todas las órdenes que cumplen filters, paginando por offset.
+// La iteración termina en el primer error.
+func (c *Client) Orders(ctx context.Context, filters OrderFilters, accessToken string) iter.Seq2[Order, error] {
+	return func(yield func(Order, error) bool) {
+		filters := filters
+		if filters.Limit <= 0 {
+			filters.Limit = defaultOrdersLimit
+		}
+		for {
+			page, err := c.SearchOrders(ctx, filters, accessToken)
+			if err != nil {
+				yield(Order{}, err)
+				return
+			}
+			for _, order := range page.Results {
+				if !yield(order, nil) {
+					return
+				}
+			}
+			offset, more := page.Paging.next(len(page.Results))
+			if !more {
+				return
+			}
+			filters.Offset = offset
+		}
+	}
+}
+
+// Orders itera las órdenes usando el Client por defecto
+func Orders(ctx context.Context, filters OrderFilters, accessToken string) iter.Seq2[Order, error] {
+	return defaultClient.Orders(ctx, filters, accessToken)
+}
+
+// LinkOrderItems obtiene con multiget los ítems de una orden y asocia cada OrderItem con su
+// Item y la variación comprada. Los fallos por ítem quedan en LinkedOrderItem.Err.
+func (c *Client) LinkOrderItems(ctx context.Context, order Order, accessToken string) ([]LinkedOrderItem, error) {
+	ids := make([]string, 0, len(order.OrderItems))
+	for _, orderItem := range order.OrderItems {
+		ids = append(ids, orderItem.Item.ID)
+	}
+
+	results, err := c.GetItems(ctx, ids, GetItemsOptions{}, accessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	linked := make([]LinkedOrderItem, len(order.OrderItems))
+	for i, orderItem := range order.OrderItems {
+		linked[i] = LinkedOrderItem{OrderItem: orderItem, Listing: results[i].Item, Err: results[i].Err}
+		if results[i].Err == nil && orderItem.Item.VariationID != nil {
+			if variation, ok := results[i].Item.FindVariation(*orderItem.Item.VariationID); ok {
+				linked[i].Variation = &variation
+			}
+		}
+	}
+	return linked, nil
+}
+
+// LinkOrderItems asocia los ítems de una orden usando el Client por defecto
+func LinkOrderItems(ctx context.Context, order Order, accessToken string) ([]LinkedOrderItem, error) {
+	return defaultClient.LinkOrderItems(ctx, order, accessToken)
+}
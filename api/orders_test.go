@@ -0,0 +1,38 @@
+package api
+
+import (
+	"testing"
+	"time"
+)
+
+func TestOrderFiltersParams(t *testing.T) {
+	zone := time.FixedZone("CST", -6*60*60)
+	filters := OrderFilters{
+		SellerID: 123,
+		BuyerID:  456,
+		Status:   "paid",
+		DateFrom: time.Date(2024, 1, 2, 3, 4, 5, 6e6, zone),
+		DateTo:   time.Date(2024, 1, 31, 23, 59, 59, 0, time.UTC),
+		Sort:     "date_desc",
+		Limit:    50,
+	}
+	params := filters.params()
+
+	want := map[string]string{
+		"seller":                  "123",
+		"buyer":                   "456",
+		"order.status":            "paid",
+		"order.date_created.from": "2024-01-02T03:04:05.006-06:00",
+		"order.date_created.to":   "2024-01-31T23:59:59.000+00:00",
+		"sort":                    "date_desc",
+		"limit":                   "50",
+	}
+	for key, value := range want {
+		if got := params.Get(key); got != value {
+			t.Errorf("%s = %q, want %q", key, got, value)
+		}
+	}
+	if len(params) != len(want) {
+		t.Errorf("params = %v, sobran parámetros", params)
+	}
+}
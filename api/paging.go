@@ -0,0 +1,16 @@
+package api
+
+// Paging representa la paginación de las búsquedas de la API
+type Paging struct {
+	Total  int `json:"total"`  // Total de resultados
+	Offset int `json:"offset"` // Desplazamiento de la página actual
+	Limit  int `json:"limit"`  // Tamaño de página
+	// Campos opcionales
+	PrimaryResults *int `json:"primary_results,omitempty"` // Resultados principales (búsquedas de sitio)
+}
+
+// next devuelve el offset de la página siguiente y si existe.
+func (p Paging) next(received int) (int, bool) {
+	offset := p.Offset + received
+	return offset, received > 0 && offset < p.Total
+}
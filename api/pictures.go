@@ -1,5 +1,16 @@
 package api
 
+import (
+	"context"
+	"io"
+	"slices"
+	"time"
+
+	"github.com/tidyrocks/mercado-libre-go-sdk/internal/http"
+)
+
+const picturesEndpoint = "/pictures"
+
 // Picture representa una imagen de un producto en Mercado Libre.
 type Picture struct {
 	ID        string `json:"id"`         // ID único de la imagen
@@ -8,4 +19,185 @@ type Picture struct {
 	Size      string `json:"size"`       // Dimensiones de la imagen (ej: "461x500")
 	MaxSize   string `json:"max_size"`   // Dimensiones máximas disponibles
 	Quality   string `json:"quality"`    // Calidad de la imagen
+	// Arrays y slices
+	Variations []PictureVariation `json:"variations,omitempty"` // Tamaños generados (respuesta de upload)
+}
+
+// PictureVariation representa un tamaño generado de una imagen subida.
+type PictureVariation struct {
+	Size      string `json:"size"`       // Dimensiones (ej: "500x500")
+	URL       string `json:"url"`        // URL de la imagen
+	SecureURL string `json:"secure_url"` // URL segura (HTTPS)
+}
+
+// PictureErrors representa los errores de procesamiento de una imagen subida.
+type PictureErrors struct {
+	ID     string       `json:"id"`     // ID de la imagen
+	Errors []ErrorCause `json:"errors"` // Errores detectados (vacío si se procesó bien)
+}
+
+// PictureRequest representa una imagen enviada al crear o actualizar un ítem
+// Se indica ID (imagen ya subida) o Source (URL pública a descargar)
+type PictureRequest struct {
+	ID     string `json:"id,omitempty"`     // ID de una imagen existente
+	Source string `json:"source,omitempty"` // URL de la imagen
+}
+
+// UploadPicture sube una imagen leyendo de file y devuelve la imagen con sus tamaños generados.
+// filename se usa para que la API infiera el formato (ej. "foto.jpg").
+func (c *Client) UploadPicture(ctx context.Context, file io.Reader, filename, accessToken string) (Picture, error) {
+	url := c.endpoint("%s/items/upload", picturesEndpoint)
+	var picture Picture
+	err := http.DoMultipartUpload(ctx, c, url, accessToken, file, filename, &picture)
+	return picture, err
+}
+
+// UploadPicture sube una imagen usando el Client por defecto.
+func UploadPicture(ctx context.Context, file io.Reader, filename, accessToken string) (Picture, error) {
+	return defaultClient.UploadPicture(ctx, file, filename, accessToken)
+}
+
+// GetPicture obtiene una imagen por ID con sus tamaños generados.
+func (c *Client) GetPicture(ctx context.Context, pictureID, accessToken string) (Picture, error) {
+	url := c.endpoint("%s/%s", picturesEndpoint, pictureID)
+	var picture Picture
+	err := http.DoGetJSON(ctx, c, url, accessToken, &picture)
+	return picture, err
+}
+
+// GetPicture obtiene una imagen usando el Client por defecto.
+func GetPicture(ctx context.Context, pictureID, accessToken string) (Picture, error) {
+	return defaultClient.GetPicture(ctx, pictureID, accessToken)
+}
+
+// GetPictureErrors obtiene los errores de procesamiento de una imagen.
+func (c *Client) GetPictureErrors(ctx context.Context, pictureID, accessToken string) (PictureErrors, error) {
+	url := c.endpoint("%s/%s/errors", picturesEndpoint, pictureID)
+	var pictureErrors PictureErrors
+	err := http.DoGetJSON(ctx, c, url, accessToken, &pictureErrors)
+	return pictureErrors, err
+}
+
+// GetPictureErrors obtiene los errores de procesamiento de una imagen usando el Client por defecto.
+func GetPictureErrors(ctx context.Context, pictureID, accessToken string) (PictureErrors, error) {
+	return defaultClient.GetPictureErrors(ctx, pictureID, accessToken)
+}
+
+// defaultPictureWaitInterval es el intervalo de consulta de WaitForPicture cuando interval <= 0.
+const defaultPictureWaitInterval = 2 * time.Second
+
+// maxPictureNotFoundPolls es la cantidad de consultas seguidas en que ambos endpoints pueden
+// responder 404 (imagen recién subida aún no visible) antes de considerar que no existe.
+const maxPictureNotFoundPolls = 5
+
+// WaitForPicture consulta cada interval (2s si es <= 0) los errores de procesamiento de una imagen
+// hasta que tenga tamaños generados, la API reporte errores o ctx se cancele. Si ambos endpoints
+// responden 404 en 5 consultas seguidas, devuelve ese error.
+func (c *Client) WaitForPicture(ctx context.Context, pictureID string, interval time.Duration, accessToken string) (Picture, PictureErrors, error) {
+	if interval <= 0 {
+		interval = defaultPictureWaitInterval
+	}
+	for notFound := 0; ; {
+		pictureErrors, errorsErr := c.GetPictureErrors(ctx, pictureID, accessToken)
+		if errorsErr != nil && !IsNotFound(errorsErr) {
+			return Picture{}, PictureErrors{}, errorsErr
+		}
+		if len(pictureErrors.Errors) > 0 {
+			return Picture{}, pictureErrors, nil
+		}
+
+		picture, err := c.GetPicture(ctx, pictureID, accessToken)
+		if err != nil && !IsNotFound(err) {
+			return Picture{}, PictureErrors{}, err
+		}
+		if len(picture.Variations) > 0 {
+			return picture, PictureErrors{}, nil
+		}
+
+		if errorsErr != nil && err != nil {
+			if notFound++; notFound >= maxPictureNotFoundPolls {
+				return Picture{}, PictureErrors{}, err
+			}
+		} else {
+			notFound = 0
+		}
+
+		if err := sleep(ctx, interval); err != nil {
+			return Picture{}, PictureErrors{}, err
+		}
+	}
+}
+
+// WaitForPicture espera el procesamiento de una imagen usando el Client por defecto.
+func WaitForPicture(ctx context.Context, pictureID string, interval time.Duration, accessToken string) (Picture, PictureErrors, error) {
+	return defaultClient.WaitForPicture(ctx, pictureID, interval, accessToken)
+}
+
+// AddItemPicture agrega una imagen ya subida al final de las imágenes de un ítem.
+func (c *Client) AddItemPicture(ctx context.Context, itemID, pictureID, accessToken string) (Picture, error) {
+	url := c.endpoint("%s/%s/pictures", itemsEndpoint, itemID)
+	var picture Picture
+	err := http.DoPostJSON(ctx, c, url, accessToken, PictureRequest{ID: pictureID}, &picture)
+	return picture, err
+}
+
+// AddItemPicture agrega una imagen a un ítem usando el Client por defecto.
+func AddItemPicture(ctx context.Context, itemID, pictureID, accessToken string) (Picture, error) {
+	return defaultClient.AddItemPicture(ctx, itemID, pictureID, accessToken)
+}
+
+// AttachVariationPictures asocia imágenes ya subidas a una variación. Las imágenes que aún no
+// estén en el ítem se agregan, y el resto de variaciones se reenvía sin cambios para no eliminarlas.
+func (c *Client) AttachVariationPictures(ctx context.Context, itemID string, variationID int64, pictureIDs []string, accessToken string) (Item, error) {
+	item, err := c.GetItem(ctx, itemID, accessToken)
+	if err != nil {
+		return Item{}, err
+	}
+
+	pictures := NewPictureRequests(item.Pictures)
+	for _, pictureID := range pictureIDs {
+		if !slices.ContainsFunc(item.Pictures, func(p Picture) bool { return p.ID == pictureID }) {
+			pictures = append(pictures, PictureRequest{ID: pictureID})
+		}
+	}
+
+	variations := make([]VariationRequest, 0, len(item.Variations))
+	for _, variation := range item.Variations {
+		request := VariationRequest{ID: &variation.ID, PictureIDs: variation.PictureIDs}
+		if variation.ID == variationID {
+			for _, pictureID := range pictureIDs {
+				if !slices.Contains(request.PictureIDs, pictureID) {
+					request.PictureIDs = append(request.PictureIDs, pictureID)
+				}
+			}
+		}
+		variations = append(variations, request)
+	}
+
+	return c.UpdateItem(ctx, itemID, ItemUpdateRequest{Pictures: pictures, Variations: variations}, accessToken)
+}
+
+// AttachVariationPictures asocia imágenes a una variación usando el Client por defecto.
+func AttachVariationPictures(ctx context.Context, itemID string, variationID int64, pictureIDs []string, accessToken string) (Item, error) {
+	return defaultClient.AttachVariationPictures(ctx, itemID, variationID, pictureIDs, accessToken)
+}
+
+// NewPictureRequests convierte imágenes leídas de la API en referencias para escritura
+func NewPictureRequests(pictures []Picture) []PictureRequest {
+	if pictures == nil {
+		return nil
+	}
+	requests := make([]PictureRequest, 0, len(pictures))
+	for _, picture := range pictures {
+		if picture.ID != "" {
+			requests = append(requests, PictureRequest{ID: picture.ID})
+			continue
+		}
+		source := picture.SecureURL
+		if source == "" {
+			source = picture.URL
+		}
+		requests = append(requests, PictureRequest{Source: source})
+	}
+	return requests
 }
@@ -0,0 +1,83 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	nethttp "net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestWaitForPictureStopsOnPermanentNotFound(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		calls.Add(1)
+		w.WriteHeader(nethttp.StatusNotFound)
+		fmt.Fprint(w, `{"message":"picture not found","error":"not_found","status":404}`)
+	}))
+	defer srv.Close()
+
+	client := NewClient(WithBaseURL(srv.URL))
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, _, err := client.WaitForPicture(ctx, "123-MLA", time.Millisecond, "")
+	if !IsNotFound(err) {
+		t.Fatalf("err = %v, want 404", err)
+	}
+	if got := calls.Load(); got != 2*maxPictureNotFoundPolls {
+		t.Errorf("peticiones = %d, want %d", got, 2*maxPictureNotFoundPolls)
+	}
+}
+
+func TestWaitForPictureDefaultInterval(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		calls.Add(1)
+		if strings.HasSuffix(r.URL.Path, "/errors") {
+			fmt.Fprint(w, `{"id":"123-MLA","errors":[]}`)
+			return
+		}
+		fmt.Fprint(w, `{"id":"123-MLA","variations":[]}`) // Aún procesándose
+	}))
+	defer srv.Close()
+
+	client := NewClient(WithBaseURL(srv.URL))
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	_, _, err := client.WaitForPicture(ctx, "123-MLA", 0, "")
+	if err != context.DeadlineExceeded {
+		t.Fatalf("err = %v, want DeadlineExceeded", err)
+	}
+	// Con interval 0 se espera el intervalo por defecto: una sola ronda de consultas
+	if got := calls.Load(); got != 2 {
+		t.Errorf("peticiones = %d, want 2", got)
+	}
+}
+
+func TestWaitForPictureReady(t *testing.T) {
+	var polls atomic.Int32
+	srv := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		if strings.HasSuffix(r.URL.Path, "/errors") {
+			fmt.Fprint(w, `{"id":"123-MLA","errors":[]}`)
+			return
+		}
+		if polls.Add(1) < 3 {
+			w.WriteHeader(nethttp.StatusNotFound)
+			fmt.Fprint(w, `{"message":"not found","status":404}`)
+			return
+		}
+		fmt.Fprint(w, `{"id":"123-MLA","variations":[{"size":"500x500"}]}`)
+	}))
+	defer srv.Close()
+
+	client := NewClient(WithBaseURL(srv.URL))
+	picture, _, err := client.WaitForPicture(context.Background(), "123-MLA", time.Millisecond, "")
+	if err != nil || len(picture.Variations) != 1 {
+		t.Fatalf("picture = %+v, err = %v", picture, err)
+	}
+}
@@ -0,0 +1,257 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/tidyrocks/mercado-libre-go-sdk/internal/http"
+)
+
+const questionsEndpoint = "/questions"
+const answersEndpoint = "/answers"
+
+// maxAnswerLength es el largo máximo de una respuesta aceptado por la API.
+const maxAnswerLength = 2000
+
+// defaultQuestionsLimit es el tamaño de página usado por el iterador de preguntas.
+const defaultQuestionsLimit = 50
+
+// ErrInvalidAnswer indica que el texto de una respuesta está vacío o supera 2000 caracteres
+var ErrInvalidAnswer = errors.New("respuesta inválida")
+
+// Question representa una pregunta de un comprador sobre un ítem
+type Question struct {
+	ID          int64        `json:"id"`           // ID de la pregunta
+	ItemID      string       `json:"item_id"`      // ID del ítem
+	SellerID    int64        `json:"seller_id"`    // ID del vendedor
+	Text        string       `json:"text"`         // Texto de la pregunta
+	Status      string       `json:"status"`       // Estado: "UNANSWERED", "ANSWERED", "CLOSED_UNANSWERED", "UNDER_REVIEW", "BANNED"
+	DateCreated time.Time    `json:"date_created"` // Fecha de creación
+	Hold        bool         `json:"hold"`         // Si está retenida por moderación
+	From        QuestionFrom `json:"from"`         // Autor de la pregunta
+	// Campos opcionales (punteros)
+	Answer             *Answer `json:"answer,omitempty"`               // Respuesta (nil si no fue respondida)
+	DeletedFromListing *bool   `json:"deleted_from_listing,omitempty"` // Si fue eliminada de la publicación
+}
+
+// QuestionFrom representa al autor de una pregunta
+type QuestionFrom struct {
+	ID                int64 `json:"id"`                 // ID del usuario
+	AnsweredQuestions int   `json:"answered_questions"` // Preguntas respondidas previamente al usuario
+}
+
+// Answer representa la respuesta del vendedor a una pregunta
+type Answer struct {
+	Text        string    `json:"text"`         // Texto de la respuesta
+	Status      string    `json:"status"`       // Estado: "ACTIVE", "DISABLED", "BANNED"
+	DateCreated time.Time `json:"date_created"` // Fecha de la respuesta
+}
+
+// QuestionFilters representa los filtros de /questions/search (se requiere ItemID o SellerID)
+type QuestionFilters struct {
+	ItemID   string // Preguntas de un ítem
+	SellerID int64  // Preguntas de todos los ítems de un vendedor
+	FromID   int64  // Preguntas de un usuario
+	Status   string // Estado: "UNANSWERED", "ANSWERED", etc.
+	Sort     string // Orden (ej. "date_created_desc")
+	Limit    int    // Tamaño de página
+	Offset   int    // Desplazamiento
+}
+
+// QuestionsSearch representa una página de resultados de /questions/search
+type QuestionsSearch struct {
+	Questions []Question `json:"questions"` // Preguntas
+	Total     int        `json:"total"`     // Total de resultados
+	Limit     int        `json:"limit"`     // Tamaño de página
+}
+
+// answerRequest representa el cuerpo para responder una pregunta (uso interno)
+type answerRequest struct {
+	QuestionID int64  `json:"question_id"` // ID de la pregunta
+	Text       string `json:"text"`        // Texto de la respuesta
+}
+
+// blockUserRequest representa el cuerpo para bloquear a un usuario (uso interno)
+type blockUserRequest struct {
+	UserID int64 `json:"user_id"` // ID del usuario a bloquear
+}
+
+// BlockedUsers representa la lista de usuarios bloqueados para preguntar
+type BlockedUsers struct {
+	Users  []BlockedUser `json:"users"`  // Usuarios bloqueados
+	Paging Paging        `json:"paging"` // Paginación
+}
+
+// BlockedUser representa un usuario bloqueado para preguntar
+type BlockedUser struct {
+	ID int64 `json:"id"` // ID del usuario
+}
+
+// ValidateAnswer verifica que el texto no esté vacío ni supere 2000 caracteres
+func ValidateAnswer(text string) error {
+	if strings.TrimSpace(text) == "" {
+		return fmt.Errorf("%w: texto vacío", ErrInvalidAnswer)
+	}
+	if length := utf8.RuneCountInString(text); length > maxAnswerLength {
+		return fmt.Errorf("%w: %d caracteres, máximo %d", ErrInvalidAnswer, length, maxAnswerLength)
+	}
+	return nil
+}
+
+// params convierte los filtros en query parameters.
+func (f QuestionFilters) params() url.Values {
+	params := url.Values{}
+	params.Set("api_version", "4")
+	if f.ItemID != "" {
+		params.Set("item", f.ItemID)
+	}
+	if f.SellerID != 0 {
+		params.Set("seller_id", strconv.FormatInt(f.SellerID, 10))
+	}
+	if f.FromID != 0 {
+		params.Set("from", strconv.FormatInt(f.FromID, 10))
+	}
+	if f.Status != "" {
+		params.Set("status", f.Status)
+	}
+	if f.Sort != "" {
+		params.Set("sort_fields", f.Sort)
+	}
+	if f.Limit > 0 {
+		params.Set("limit", strconv.Itoa(f.Limit))
+	}
+	if f.Offset > 0 {
+		params.Set("offset", strconv.Itoa(f.Offset))
+	}
+	return params
+}
+
+// SearchQuestions obtiene una página de preguntas por ítem o por vendedor
+func (c *Client) SearchQuestions(ctx context.Context, filters QuestionFilters, accessToken string) (QuestionsSearch, error) {
+	url := c.endpoint("%s/search", questionsEndpoint)
+	var search QuestionsSearch
+	err := http.DoGetJSONWithParams(ctx, c, url, accessToken, filters.params(), &search)
+	return search, err
+}
+
+// SearchQuestions obtiene una página de preguntas usando el Client por defecto
+func SearchQuestions(ctx context.Context, filters QuestionFilters, accessToken string) (QuestionsSearch, error) {
+	return defaultClient.SearchQuestions(ctx, filters, accessToken)
+}
+
+// Questions itera todas las preguntas que cumplen filters, paginando por offset.
+// La iteración termina en el primer error.
+func (c *Client) Questions(ctx context.Context, filters QuestionFilters, accessToken string) iter.Seq2[Question, error] {
+	return func(yield func(Question, error) bool) {
+		filters := filters
+		if filters.Limit <= 0 {
+			filters.Limit = defaultQuestionsLimit
+		}
+		for {
+			page, err := c.SearchQuestions(ctx, filters, accessToken)
+			if err != nil {
+				yield(Question{}, err)
+				return
+			}
+			for _, question := range page.Questions {
+				if !yield(question, nil) {
+					return
+				}
+			}
+			paging := Paging{Total: page.Total, Offset: filters.Offset, Limit: page.Limit}
+			offset, more := paging.next(len(page.Questions))
+			if !more {
+				return
+			}
+			filters.Offset = offset
+		}
+	}
+}
+
+// Questions itera las preguntas usando el Client por defecto
+func Questions(ctx context.Context, filters QuestionFilters, accessToken string) iter.Seq2[Question, error] {
+	return defaultClient.Questions(ctx, filters, accessToken)
+}
+
+// GetQuestion obtiene una pregunta por su ID
+func (c *Client) GetQuestion(ctx context.Context, questionID int64, accessToken string) (Question, error) {
+	url := c.endpoint("%s/%d?api_version=4", questionsEndpoint, questionID)
+	var question Question
+	err := http.DoGetJSON(ctx, c, url, accessToken, &question)
+	return question, err
+}
+
+// GetQuestion obtiene una pregunta usando el Client por defecto
+func GetQuestion(ctx context.Context, questionID int64, accessToken string) (Question, error) {
+	return defaultClient.GetQuestion(ctx, questionID, accessToken)
+}
+
+// AnswerQuestion responde una pregunta. El texto se valida antes de enviar.
+func (c *Client) AnswerQuestion(ctx context.Context, questionID int64, text, accessToken string) (Question, error) {
+	if err := ValidateAnswer(text); err != nil {
+		return Question{}, err
+	}
+	request := answerRequest{QuestionID: questionID, Text: text}
+	var question Question
+	err := http.DoPostJSON(ctx, c, c.endpoint(answersEndpoint), accessToken, request, &question)
+	return question, err
+}
+
+// AnswerQuestion responde una pregunta usando el Client por defecto
+func AnswerQuestion(ctx context.Context, questionID int64, text, accessToken string) (Question, error) {
+	return defaultClient.AnswerQuestion(ctx, questionID, text, accessToken)
+}
+
+// DeleteQuestion elimina una pregunta de la publicación
+func (c *Client) DeleteQuestion(ctx context.Context, questionID int64, accessToken string) error {
+	url := c.endpoint("%s/%d", questionsEndpoint, questionID)
+	return http.DoDelete(ctx, c, url, accessToken)
+}
+
+// DeleteQuestion elimina una pregunta usando el Client por defecto
+func DeleteQuestion(ctx context.Context, questionID int64, accessToken string) error {
+	return defaultClient.DeleteQuestion(ctx, questionID, accessToken)
+}
+
+// BlockUserQuestions impide que userID haga preguntas en las publicaciones de sellerID
+func (c *Client) BlockUserQuestions(ctx context.Context, sellerID, userID int64, accessToken string) error {
+	url := c.endpoint("%s/%d/questions_blacklist", usersEndpoint, sellerID)
+	var response struct{}
+	return http.DoPostJSON(ctx, c, url, accessToken, blockUserRequest{UserID: userID}, &response)
+}
+
+// BlockUserQuestions bloquea a un usuario usando el Client por defecto
+func BlockUserQuestions(ctx context.Context, sellerID, userID int64, accessToken string) error {
+	return defaultClient.BlockUserQuestions(ctx, sellerID, userID, accessToken)
+}
+
+// UnblockUserQuestions vuelve a permitir que userID haga preguntas a sellerID
+func (c *Client) UnblockUserQuestions(ctx context.Context, sellerID, userID int64, accessToken string) error {
+	url := c.endpoint("%s/%d/questions_blacklist/%d", usersEndpoint, sellerID, userID)
+	return http.DoDelete(ctx, c, url, accessToken)
+}
+
+// UnblockUserQuestions desbloquea a un usuario usando el Client por defecto
+func UnblockUserQuestions(ctx context.Context, sellerID, userID int64, accessToken string) error {
+	return defaultClient.UnblockUserQuestions(ctx, sellerID, userID, accessToken)
+}
+
+// GetBlockedUsers obtiene los usuarios bloqueados para preguntar a sellerID
+func (c *Client) GetBlockedUsers(ctx context.Context, sellerID int64, accessToken string) (BlockedUsers, error) {
+	url := c.endpoint("%s/%d/questions_blacklist", usersEndpoint, sellerID)
+	var blocked BlockedUsers
+	err := http.DoGetJSON(ctx, c, url, accessToken, &blocked)
+	return blocked, err
+}
+
+// GetBlockedUsers obtiene los usuarios bloqueados usando el Client por defecto
+func GetBlockedUsers(ctx context.Context, sellerID int64, accessToken string) (BlockedUsers, error) {
+	return defaultClient.GetBlockedUsers(ctx, sellerID, accessToken)
+}
@@ -0,0 +1,35 @@
+package api
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestQuestionFiltersParams(t *testing.T) {
+	tests := []struct {
+		name    string
+		filters QuestionFilters
+		want    url.Values
+	}{
+		{
+			"por ítem",
+			QuestionFilters{ItemID: "MLM1", Status: "UNANSWERED", Sort: "date_created_desc"},
+			url.Values{"api_version": {"4"}, "item": {"MLM1"}, "status": {"UNANSWERED"}, "sort_fields": {"date_created_desc"}},
+		},
+		{
+			"por vendedor",
+			QuestionFilters{SellerID: 123, Limit: 50, Offset: 100},
+			url.Values{"api_version": {"4"}, "seller_id": {"123"}, "limit": {"50"}, "offset": {"100"}},
+		},
+		{
+			"sin filtros",
+			QuestionFilters{},
+			url.Values{"api_version": {"4"}},
+		},
+	}
+	for _, tt := range tests {
+		if got := tt.filters.params().Encode(); got != tt.want.Encode() {
+			t.Errorf("%s: params = %s, want %s", tt.name, got, tt.want.Encode())
+		}
+	}
+}
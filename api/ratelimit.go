@@ -0,0 +1,231 @@
+package api
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// RateLimit define un token bucket: Rate peticiones por segundo con ráfagas de hasta Burst.
+// Rate <= 0 desactiva el límite.
+type RateLimit struct {
+	Rate  float64 // Peticiones por segundo sostenidas
+	Burst int     // Peticiones permitidas en ráfaga (mínimo 1)
+}
+
+// RateLimiterConfig configura los buckets de un RateLimiter.
+// Cada petición consume un token de cada bucket que le aplica y espera al más lento.
+type RateLimiterConfig struct {
+	App       RateLimit            // Límite global de la aplicación
+	PerSeller RateLimit            // Límite por vendedor (Token.UserID de la TokenSource o el del access token)
+	PerFamily map[string]RateLimit // Límite por familia de endpoint (primer segmento: "items", "orders", etc.)
+}
+
+// RateLimiterStats contiene métricas acumuladas de espera de un RateLimiter.
+type RateLimiterStats struct {
+	Requests  int64         // Peticiones que pasaron por el limitador
+	Delayed   int64         // Peticiones que tuvieron que esperar
+	Waiting   int64         // Peticiones esperando en este momento
+	TotalWait time.Duration // Tiempo total esperado
+	MaxWait   time.Duration // Mayor espera individual
+}
+
+// RateLimiter limita peticiones con token buckets por aplicación, por vendedor y por familia de endpoint.
+// Es seguro para uso concurrente y puede compartirse entre varios Client.
+type RateLimiter struct {
+	config RateLimiterConfig
+	app    *bucket
+
+	mu       sync.Mutex
+	sellers  map[int64]*bucket
+	families map[string]*bucket
+
+	requests  atomic.Int64
+	delayed   atomic.Int64
+	waiting   atomic.Int64
+	totalWait atomic.Int64
+	maxWait   atomic.Int64
+}
+
+// NewRateLimiter crea un RateLimiter con la configuración indicada.
+func NewRateLimiter(config RateLimiterConfig) *RateLimiter {
+	return &RateLimiter{
+		config:   config,
+		app:      newBucket(config.App),
+		sellers:  make(map[int64]*bucket),
+		families: make(map[string]*bucket),
+	}
+}
+
+// WithRateLimiter limita las peticiones del Client con limiter.
+func WithRateLimiter(limiter *RateLimiter) Option {
+	return func(c *Client) {
+		c.limiter = limiter
+	}
+}
+
+// buckets devuelve los buckets que aplican a userID y family (creándolos si hace falta).
+func (l *RateLimiter) buckets(userID int64, family string) []*bucket {
+	buckets := make([]*bucket, 0, 3)
+	if l.app != nil {
+		buckets = append(buckets, l.app)
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if userID != 0 && l.config.PerSeller.Rate > 0 {
+		b, ok := l.sellers[userID]
+		if !ok {
+			b = newBucket(l.config.PerSeller)
+			l.sellers[userID] = b
+		}
+		buckets = append(buckets, b)
+	}
+	if limit, ok := l.config.PerFamily[family]; ok && limit.Rate > 0 {
+		b, ok := l.families[family]
+		if !ok {
+			b = newBucket(limit)
+			l.families[family] = b
+		}
+		buckets = append(buckets, b)
+	}
+	return buckets
+}
+
+// Wait bloquea hasta que la petición de userID a la familia indicada pueda enviarse o ctx se cancele.
+func (l *RateLimiter) Wait(ctx context.Context, userID int64, family string) error {
+	l.requests.Add(1)
+	buckets := l.buckets(userID, family)
+
+	now := time.Now()
+	var wait time.Duration
+	for _, b := range buckets {
+		wait = max(wait, b.reserve(now))
+	}
+	if wait <= 0 {
+		return nil
+	}
+
+	l.delayed.Add(1)
+	l.waiting.Add(1)
+	defer l.waiting.Add(-1)
+
+	if err := sleep(ctx, wait); err != nil {
+		// Devolver los tokens reservados para no penalizar a las demás peticiones
+		for _, b := range buckets {
+			b.cancel()
+		}
+		return err
+	}
+
+	l.totalWait.Add(int64(wait))
+	for {
+		current := l.maxWait.Load()
+		if int64(wait) <= current || l.maxWait.CompareAndSwap(current, int64(wait)) {
+			break
+		}
+	}
+	return nil
+}
+
+// EstimatedWait devuelve cuánto esperaría ahora una petición de userID a family, sin consumir tokens.
+func (l *RateLimiter) EstimatedWait(userID int64, family string) time.Duration {
+	now := time.Now()
+	var wait time.Duration
+	for _, b := range l.buckets(userID, family) {
+		wait = max(wait, b.estimate(now))
+	}
+	return wait
+}
+
+// Stats devuelve las métricas acumuladas de espera.
+func (l *RateLimiter) Stats() RateLimiterStats {
+	return RateLimiterStats{
+		Requests:  l.requests.Load(),
+		Delayed:   l.delayed.Load(),
+		Waiting:   l.waiting.Load(),
+		TotalWait: time.Duration(l.totalWait.Load()),
+		MaxWait:   time.Duration(l.maxWait.Load()),
+	}
+}
+
+// bucket implementa un token bucket que permite saldo negativo para reservar turnos.
+type bucket struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// newBucket crea un bucket lleno; devuelve nil si el límite está desactivado.
+func newBucket(limit RateLimit) *bucket {
+	if limit.Rate <= 0 {
+		return nil
+	}
+	burst := float64(max(limit.Burst, 1))
+	return &bucket{rate: limit.Rate, burst: burst, tokens: burst, last: time.Now()}
+}
+
+// refill agrega los tokens acumulados desde la última consulta; debe llamarse con mu tomado.
+func (b *bucket) refill(now time.Time) {
+	if elapsed := now.Sub(b.last).Seconds(); elapsed > 0 {
+		b.tokens = min(b.burst, b.tokens+elapsed*b.rate)
+		b.last = now
+	}
+}
+
+// reserve consume un token y devuelve cuánto hay que esperar para usarlo.
+func (b *bucket) reserve(now time.Time) time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.refill(now)
+	b.tokens--
+	if b.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
+
+// estimate devuelve la espera para un token sin consumirlo.
+func (b *bucket) estimate(now time.Time) time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.refill(now)
+	if b.tokens >= 1 {
+		return 0
+	}
+	return time.Duration((1 - b.tokens) / b.rate * float64(time.Second))
+}
+
+// cancel devuelve un token reservado.
+func (b *bucket) cancel() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.tokens = min(b.burst, b.tokens+1)
+}
+
+// endpointFamily devuelve el primer segmento del path relativo a la URL base (ej. "/items/MLM1" -> "items").
+func endpointFamily(path, basePath string) string {
+	path = strings.TrimPrefix(strings.TrimPrefix(path, basePath), "/")
+	family, _, _ := strings.Cut(path, "/")
+	return family
+}
+
+// userIDFromAuthorization extrae el UserID del header Authorization cuando el token no viene de una TokenSource.
+// Los access tokens de MELI terminan en el ID del usuario (APP_USR-<app>-<fecha>-<hash>-<user_id>).
+func userIDFromAuthorization(header string) int64 {
+	token := strings.TrimPrefix(header, "Bearer ")
+	index := strings.LastIndex(token, "-")
+	if index < 0 {
+		return 0
+	}
+	userID, err := strconv.ParseInt(token[index+1:], 10, 64)
+	if err != nil {
+		return 0
+	}
+	return userID
+}
@@ -0,0 +1,120 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	nethttp "net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestBucketReserve(t *testing.T) {
+	b := newBucket(RateLimit{Rate: 10, Burst: 2})
+	now := b.last
+
+	// La ráfaga pasa sin esperar y luego cada petición espera 1/Rate más que la anterior
+	want := []time.Duration{0, 0, 100 * time.Millisecond, 200 * time.Millisecond}
+	for i, w := range want {
+		if got := b.reserve(now); got != w {
+			t.Errorf("reserva %d: espera %v, want %v", i, got, w)
+		}
+	}
+
+	// Pasado medio segundo se recuperan 5 tokens sobre el saldo de -2, sin superar Burst
+	later := now.Add(500 * time.Millisecond)
+	if got := b.estimate(later); got != 0 {
+		t.Errorf("estimate tras 500ms = %v, want 0", got)
+	}
+	if b.tokens != 2 {
+		t.Errorf("tokens = %v, want 2 (Burst)", b.tokens)
+	}
+}
+
+func TestBucketEstimateAndCancel(t *testing.T) {
+	b := newBucket(RateLimit{Rate: 4, Burst: 1})
+	now := b.last
+
+	b.reserve(now)
+	if got := b.estimate(now); got != 250*time.Millisecond {
+		t.Errorf("estimate = %v, want 250ms", got)
+	}
+	if got := b.reserve(now); got != 250*time.Millisecond {
+		t.Errorf("reserve = %v, want 250ms", got)
+	}
+	b.cancel()
+	if got := b.estimate(now); got != 250*time.Millisecond {
+		t.Errorf("estimate tras cancel = %v, want 250ms", got)
+	}
+}
+
+func TestNewBucketDisabled(t *testing.T) {
+	if b := newBucket(RateLimit{Rate: 0, Burst: 5}); b != nil {
+		t.Errorf("bucket = %+v, want nil con Rate 0", b)
+	}
+}
+
+func TestRateLimiterWaitCanceled(t *testing.T) {
+	limiter := NewRateLimiter(RateLimiterConfig{App: RateLimit{Rate: 1, Burst: 1}})
+	if err := limiter.Wait(context.Background(), 0, "items"); err != nil {
+		t.Fatalf("Wait: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if err := limiter.Wait(ctx, 0, "items"); err != context.DeadlineExceeded {
+		t.Fatalf("err = %v, want DeadlineExceeded", err)
+	}
+	// El token reservado se devolvió: la espera sigue siendo de ~1s y no de ~2s
+	if got := limiter.EstimatedWait(0, "items"); got > time.Second {
+		t.Errorf("EstimatedWait = %v, want <= 1s", got)
+	}
+
+	stats := limiter.Stats()
+	if stats.Requests != 2 || stats.Delayed != 1 || stats.Waiting != 0 {
+		t.Errorf("stats = %+v", stats)
+	}
+}
+
+// fixedTokenSource devuelve siempre el mismo token.
+type fixedTokenSource Token
+
+func (s fixedTokenSource) Token(context.Context) (Token, error) {
+	return Token(s), nil
+}
+
+func TestRateLimiterUsesTokenSourceUserID(t *testing.T) {
+	srv := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		fmt.Fprint(w, `{"id":"MLM1"}`)
+	}))
+	defer srv.Close()
+
+	limiter := NewRateLimiter(RateLimiterConfig{PerSeller: RateLimit{Rate: 0.5, Burst: 1}})
+	// El access token no termina en el UserID: solo la TokenSource lo conoce
+	source := fixedTokenSource{AccessToken: "opaque", UserID: 42}
+	client := NewClient(WithBaseURL(srv.URL), WithTokenSource(source), WithRateLimiter(limiter))
+
+	if _, err := client.GetItem(context.Background(), "MLM1", ""); err != nil {
+		t.Fatalf("GetItem: %v", err)
+	}
+	if got := limiter.EstimatedWait(42, "items"); got <= time.Second {
+		t.Errorf("EstimatedWait(42) = %v, want ~2s", got)
+	}
+	if got := limiter.EstimatedWait(0, "items"); got != 0 {
+		t.Errorf("EstimatedWait(0) = %v, want 0", got)
+	}
+}
+
+func TestUserIDFromAuthorization(t *testing.T) {
+	tests := map[string]int64{
+		"Bearer APP_USR-123-101010-abc-987654": 987654,
+		"Bearer opaque":                        0,
+		"Bearer APP_USR-123-abc":               0,
+		"":                                     0,
+	}
+	for header, want := range tests {
+		if got := userIDFromAuthorization(header); got != want {
+			t.Errorf("userIDFromAuthorization(%q) = %d, want %d", header, got, want)
+		}
+	}
+}
@@ -0,0 +1,148 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	nethttp "net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy define cómo el Client reintenta peticiones fallidas por errores transitorios
+// (errores de red, 429 y 5xx de gateway).
+type RetryPolicy struct {
+	MaxAttempts int           // Intentos totales incluyendo el primero (<= 1 desactiva reintentos)
+	BaseDelay   time.Duration // Espera base antes del primer reintento
+	MaxDelay    time.Duration // Tope de espera entre intentos; un Retry-After mayor no se reintenta
+	RetryPOST   bool          // Si también se reintentan POST (no idempotentes)
+	// Campos opcionales
+	OnAttempt func(RetryAttempt) // Hook invocado después de cada intento
+}
+
+// RetryAttempt describe el resultado de un intento, entregado a RetryPolicy.OnAttempt.
+type RetryAttempt struct {
+	Attempt    int           // Número de intento (desde 1)
+	Method     string        // Método HTTP
+	URL        string        // URL solicitada
+	StatusCode int           // Status de la respuesta (0 si hubo error de red)
+	Err        error         // Error del intento (nil si fue exitoso)
+	Retry      bool          // Si se hará otro intento
+	Delay      time.Duration // Espera antes del siguiente intento
+}
+
+// DefaultRetryPolicy es una política razonable para sincronizaciones: 4 intentos con backoff de 500ms a 30s.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 4,
+	BaseDelay:   500 * time.Millisecond,
+	MaxDelay:    30 * time.Second,
+}
+
+// WithRetryPolicy activa reintentos automáticos con la política indicada.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(c *Client) {
+		c.retry = policy
+	}
+}
+
+// retryableStatus indica los status considerados transitorios.
+func retryableStatus(status int) bool {
+	switch status {
+	case nethttp.StatusTooManyRequests,
+		nethttp.StatusInternalServerError,
+		nethttp.StatusBadGateway,
+		nethttp.StatusServiceUnavailable,
+		nethttp.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// canRetry indica si el método y el cuerpo de req permiten reenviarla.
+func (p RetryPolicy) canRetry(req *nethttp.Request) bool {
+	if req.Body != nil && req.Body != nethttp.NoBody && req.GetBody == nil {
+		return false
+	}
+	switch req.Method {
+	case nethttp.MethodGet, nethttp.MethodHead, nethttp.MethodOptions, nethttp.MethodPut, nethttp.MethodDelete:
+		return true
+	case nethttp.MethodPost:
+		return p.RetryPOST
+	}
+	return false
+}
+
+// shouldRetry decide si el resultado de un intento amerita reintentar.
+func (p RetryPolicy) shouldRetry(req *nethttp.Request, attempt int, err error) bool {
+	if err == nil || attempt >= p.MaxAttempts || !p.canRetry(req) {
+		return false
+	}
+	if req.Context().Err() != nil {
+		return false
+	}
+	var apiErr *Error
+	if errors.As(err, &apiErr) {
+		// Si el servidor pide esperar más que MaxDelay se devuelve el error para que el llamador reprograme
+		if d, ok := parseRetryAfter(apiErr.Header.Get("Retry-After")); ok && p.MaxDelay > 0 && d > p.MaxDelay {
+			return false
+		}
+		return retryableStatus(apiErr.StatusCode)
+	}
+	// Error de red: se reintenta salvo que sea cancelación del contexto
+	return !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+}
+
+// delay calcula la espera antes del intento attempt+1: Retry-After si viene en la respuesta,
+// o backoff exponencial con jitter limitado a MaxDelay si es mayor que cero.
+func (p RetryPolicy) delay(attempt int, err error) time.Duration {
+	var apiErr *Error
+	if errors.As(err, &apiErr) {
+		if d, ok := parseRetryAfter(apiErr.Header.Get("Retry-After")); ok {
+			return d
+		}
+	}
+
+	backoff := p.BaseDelay << (attempt - 1)
+	if p.MaxDelay > 0 && (backoff > p.MaxDelay || backoff <= 0) {
+		backoff = p.MaxDelay
+	}
+	if backoff <= 0 {
+		return 0
+	}
+	// Equal jitter: la mitad fija y la otra mitad aleatoria
+	half := backoff / 2
+	return half + rand.N(half+1)
+}
+
+// parseRetryAfter interpreta el header Retry-After en segundos o como fecha HTTP.
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if at, err := nethttp.ParseTime(value); err == nil {
+		d := time.Until(at)
+		if d < 0 {
+			d = 0
+		}
+		return d, true
+	}
+	return 0, false
+}
+
+// sleep espera d o hasta que ctx se cancele.
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,239 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"io"
+	nethttp "net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// statusSequence devuelve un servidor que responde los status indicados en orden
+// (el último se repite) y cuenta las peticiones recibidas.
+func statusSequence(t *testing.T, header nethttp.Header, statuses ...int) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+	var calls atomic.Int32
+	srv := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		io.Copy(io.Discard, r.Body)
+		n := int(calls.Add(1))
+		status := statuses[min(n, len(statuses))-1]
+		if status >= 400 {
+			for key, values := range header {
+				w.Header()[key] = values
+			}
+		}
+		w.WriteHeader(status)
+		io.WriteString(w, `{}`)
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &calls
+}
+
+func newRetryRequest(t *testing.T, ctx context.Context, method, url string, body io.Reader) *nethttp.Request {
+	t.Helper()
+	req, err := nethttp.NewRequestWithContext(ctx, method, url, body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return req
+}
+
+func TestRetrySucceedsAfterUnavailable(t *testing.T) {
+	srv, calls := statusSequence(t, nil, 503, 503, 200)
+	client := NewClient(WithRetryPolicy(RetryPolicy{MaxAttempts: 4, BaseDelay: time.Millisecond}))
+
+	resp, err := client.Do(newRetryRequest(t, context.Background(), nethttp.MethodGet, srv.URL, nil))
+	if err != nil {
+		t.Fatalf("Do: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != 200 {
+		t.Errorf("status = %d, want 200", resp.StatusCode)
+	}
+	if got := calls.Load(); got != 3 {
+		t.Errorf("peticiones = %d, want 3", got)
+	}
+}
+
+func TestRetryGivesUpAfterMaxAttempts(t *testing.T) {
+	srv, calls := statusSequence(t, nil, 503)
+	client := NewClient(WithRetryPolicy(RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond}))
+
+	_, err := client.Do(newRetryRequest(t, context.Background(), nethttp.MethodGet, srv.URL, nil))
+	if !IsServerError(err) {
+		t.Fatalf("err = %v, want 503", err)
+	}
+	if got := calls.Load(); got != 3 {
+		t.Errorf("peticiones = %d, want 3", got)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	if d, ok := parseRetryAfter("3"); !ok || d != 3*time.Second {
+		t.Errorf("segundos: got %v %v, want 3s true", d, ok)
+	}
+
+	at := time.Now().Add(10 * time.Second).UTC().Format(nethttp.TimeFormat)
+	d, ok := parseRetryAfter(at)
+	if !ok || d <= 8*time.Second || d > 10*time.Second {
+		t.Errorf("fecha HTTP: got %v %v, want ~10s true", d, ok)
+	}
+
+	past := time.Now().Add(-time.Hour).UTC().Format(nethttp.TimeFormat)
+	if d, ok := parseRetryAfter(past); !ok || d != 0 {
+		t.Errorf("fecha pasada: got %v %v, want 0 true", d, ok)
+	}
+
+	if _, ok := parseRetryAfter("mañana"); ok {
+		t.Error("valor inválido aceptado")
+	}
+}
+
+func TestRetryAfterSeconds(t *testing.T) {
+	srv, calls := statusSequence(t, nethttp.Header{"Retry-After": {"3600"}}, 429, 200)
+
+	var attempts []RetryAttempt
+	client := NewClient(WithRetryPolicy(RetryPolicy{
+		MaxAttempts: 3,
+		BaseDelay:   time.Millisecond,
+		MaxDelay:    30 * time.Second,
+		OnAttempt:   func(a RetryAttempt) { attempts = append(attempts, a) },
+	}))
+
+	_, err := client.Do(newRetryRequest(t, context.Background(), nethttp.MethodGet, srv.URL, nil))
+	if !IsRateLimited(err) {
+		t.Fatalf("err = %v, want 429", err)
+	}
+	var apiErr *Error
+	if errors.As(err, &apiErr) && apiErr.Header.Get("Retry-After") != "3600" {
+		t.Errorf("Retry-After = %q, want 3600", apiErr.Header.Get("Retry-After"))
+	}
+	// Retry-After mayor que MaxDelay: no se reintenta antes de lo pedido por el servidor
+	if got := calls.Load(); got != 1 {
+		t.Errorf("peticiones = %d, want 1", got)
+	}
+	if len(attempts) != 1 || attempts[0].Retry {
+		t.Errorf("intentos = %+v, want uno sin reintento", attempts)
+	}
+}
+
+func TestRetryAfterHTTPDate(t *testing.T) {
+	header := nethttp.Header{"Retry-After": {time.Now().Add(-time.Minute).UTC().Format(nethttp.TimeFormat)}}
+	srv, calls := statusSequence(t, header, 503, 200)
+
+	var delays []time.Duration
+	client := NewClient(WithRetryPolicy(RetryPolicy{
+		MaxAttempts: 2,
+		BaseDelay:   time.Hour, // Si se ignorara Retry-After el test quedaría bloqueado
+		OnAttempt:   func(a RetryAttempt) { delays = append(delays, a.Delay) },
+	}))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	resp, err := client.Do(newRetryRequest(t, ctx, nethttp.MethodGet, srv.URL, nil))
+	if err != nil {
+		t.Fatalf("Do: %v", err)
+	}
+	resp.Body.Close()
+	if calls.Load() != 2 {
+		t.Errorf("peticiones = %d, want 2", calls.Load())
+	}
+	if len(delays) == 0 || delays[0] != 0 {
+		t.Errorf("delay = %v, want 0", delays)
+	}
+}
+
+func TestRetryPOST(t *testing.T) {
+	for _, retryPOST := range []bool{false, true} {
+		srv, calls := statusSequence(t, nil, 503)
+		client := NewClient(WithRetryPolicy(RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond, RetryPOST: retryPOST}))
+
+		_, err := client.Do(newRetryRequest(t, context.Background(), nethttp.MethodPost, srv.URL, strings.NewReader(`{"a":1}`)))
+		if !IsServerError(err) {
+			t.Fatalf("RetryPOST=%v: err = %v, want 503", retryPOST, err)
+		}
+		want := int32(1)
+		if retryPOST {
+			want = 3
+		}
+		if got := calls.Load(); got != want {
+			t.Errorf("RetryPOST=%v: peticiones = %d, want %d", retryPOST, got, want)
+		}
+	}
+}
+
+func TestRetrySkipsStreamingBody(t *testing.T) {
+	srv, calls := statusSequence(t, nil, 503, 200)
+	client := NewClient(WithRetryPolicy(RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond}))
+
+	// io.MultiReader no es uno de los tipos para los que net/http define GetBody
+	body := io.MultiReader(strings.NewReader(`{"a":1}`))
+	_, err := client.Do(newRetryRequest(t, context.Background(), nethttp.MethodPut, srv.URL, body))
+	if !IsServerError(err) {
+		t.Fatalf("err = %v, want 503", err)
+	}
+	if got := calls.Load(); got != 1 {
+		t.Errorf("peticiones = %d, want 1", got)
+	}
+}
+
+func TestRetryOnAttempt(t *testing.T) {
+	srv, _ := statusSequence(t, nil, 502, 503, 200)
+
+	var attempts []RetryAttempt
+	client := NewClient(WithRetryPolicy(RetryPolicy{
+		MaxAttempts: 4,
+		BaseDelay:   time.Millisecond,
+		OnAttempt:   func(a RetryAttempt) { attempts = append(attempts, a) },
+	}))
+
+	resp, err := client.Do(newRetryRequest(t, context.Background(), nethttp.MethodGet, srv.URL, nil))
+	if err != nil {
+		t.Fatalf("Do: %v", err)
+	}
+	resp.Body.Close()
+
+	want := []struct {
+		status int
+		retry  bool
+	}{{502, true}, {503, true}, {200, false}}
+	if len(attempts) != len(want) {
+		t.Fatalf("intentos = %d, want %d", len(attempts), len(want))
+	}
+	for i, a := range attempts {
+		if a.Attempt != i+1 || a.StatusCode != want[i].status || a.Retry != want[i].retry {
+			t.Errorf("intento %d = {Attempt:%d Status:%d Retry:%v}, want {%d %d %v}",
+				i, a.Attempt, a.StatusCode, a.Retry, i+1, want[i].status, want[i].retry)
+		}
+		if a.Method != nethttp.MethodGet || a.URL != srv.URL {
+			t.Errorf("intento %d: %s %s", i, a.Method, a.URL)
+		}
+		if (a.Err == nil) != (a.StatusCode == 200) {
+			t.Errorf("intento %d: Err = %v con status %d", i, a.Err, a.StatusCode)
+		}
+	}
+}
+
+func TestRetryContextCanceledDuringBackoff(t *testing.T) {
+	srv, calls := statusSequence(t, nil, 503)
+	client := NewClient(WithRetryPolicy(RetryPolicy{MaxAttempts: 3, BaseDelay: time.Hour, MaxDelay: time.Hour}))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := client.Do(newRetryRequest(t, ctx, nethttp.MethodGet, srv.URL, nil))
+	if !IsServerError(err) {
+		t.Errorf("err = %v, want el 503 original", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("tardó %v, la cancelación no interrumpió la espera", elapsed)
+	}
+	if got := calls.Load(); got != 1 {
+		t.Errorf("peticiones = %d, want 1", got)
+	}
+}
@@ -0,0 +1,154 @@
+package api
+
+import (
+	"context"
+	"iter"
+	"net/url"
+	"strconv"
+
+	"github.com/tidyrocks/mercado-libre-go-sdk/internal/http"
+)
+
+const usersEndpoint = "/users"
+
+// maxSearchOffset es el offset máximo permitido por /users/{id}/items/search sin scan.
+const maxSearchOffset = 1000
+
+// defaultSellerItemsLimit es el tamaño de página usado por el iterador.
+const defaultSellerItemsLimit = 100
+
+// SellerItemsFilters representa los filtros de búsqueda de ítems de un vendedor
+type SellerItemsFilters struct {
+	Status        string // Estado: "active", "paused", "closed", "under_review"
+	ListingTypeID string // Tipo de publicación: "gold_special", "gold_pro", etc.
+	SellerSKU     string // SKU del vendedor (seller_custom_field o SELLER_SKU)
+	LogisticType  string // Tipo logístico: "fulfillment", "cross_docking", etc.
+	Sort          string // Orden (ej. "date_desc")
+	Limit         int    // Tamaño de página (máximo 100)
+	Offset        int    // Desplazamiento (máximo 1000 sin scan)
+	// Campos de scan
+	ScrollID string // scroll_id devuelto por la página anterior en modo scan
+	Scan     bool   // Usa search_type=scan (sin límite de offset)
+}
+
+// SellerItemsSearch representa una página de IDs de ítems de un vendedor
+type SellerItemsSearch struct {
+	Results  []string `json:"results"`   // IDs de ítems
+	Paging   Paging   `json:"paging"`    // Paginación
+	ScrollID string   `json:"scroll_id"` // Cursor para la siguiente página (modo scan)
+}
+
+// params convierte los filtros en query parameters.
+func (f SellerItemsFilters) params() url.Values {
+	params := url.Values{}
+	if f.Status != "" {
+		params.Set("status", f.Status)
+	}
+	if f.ListingTypeID != "" {
+		params.Set("listing_type_id", f.ListingTypeID)
+	}
+	if f.SellerSKU != "" {
+		params.Set("seller_sku", f.SellerSKU)
+	}
+	if f.LogisticType != "" {
+		params.Set("logistic_type", f.LogisticType)
+	}
+	if f.Sort != "" {
+		params.Set("sort", f.Sort)
+	}
+	if f.Limit > 0 {
+		params.Set("limit", strconv.Itoa(f.Limit))
+	}
+	if f.Scan {
+		params.Set("search_type", "scan")
+		if f.ScrollID != "" {
+			params.Set("scroll_id", f.ScrollID)
+		}
+	} else if f.Offset > 0 {
+		params.Set("offset", strconv.Itoa(f.Offset))
+	}
+	return params
+}
+
+// SearchSellerItems obtiene una página de IDs de ítems de un vendedor
+func (c *Client) SearchSellerItems(ctx context.Context, sellerID int64, filters SellerItemsFilters, accessToken string) (SellerItemsSearch, error) {
+	url := c.endpoint("%s/%d/items/search", usersEndpoint, sellerID)
+	var search SellerItemsSearch
+	err := http.DoGetJSONWithParams(ctx, c, url, accessToken, filters.params(), &search)
+	return search, err
+}
+
+// SearchSellerItems obtiene una página de IDs de ítems usando el Client por defecto
+func SearchSellerItems(ctx context.Context, sellerID int64, filters SellerItemsFilters, accessToken string) (SellerItemsSearch, error) {
+	return defaultClient.SearchSellerItems(ctx, sellerID, filters, accessToken)
+}
+
+// SellerItems itera todos los IDs de ítems de un vendedor que cumplen filters.
+// Pagina por offset y, si el total supera el límite de 1000, continúa en modo scan con scroll_id
+// omitiendo los IDs ya entregados. La iteración termina en el primer error.
+func (c *Client) SellerItems(ctx context.Context, sellerID int64, filters SellerItemsFilters, accessToken string) iter.Seq2[string, error] {
+	return func(yield func(string, error) bool) {
+		filters := filters
+		if filters.Limit <= 0 {
+			filters.Limit = defaultSellerItemsLimit
+		}
+		seen := make(map[string]bool)
+
+		// Paginación por offset mientras el total lo permita
+		if !filters.Scan {
+			for {
+				page, err := c.SearchSellerItems(ctx, sellerID, filters, accessToken)
+				if err != nil {
+					yield("", err)
+					return
+				}
+				for _, id := range page.Results {
+					seen[id] = true
+					if !yield(id, nil) {
+						return
+					}
+				}
+				if page.Paging.Total > maxSearchOffset {
+					break
+				}
+				offset, more := page.Paging.next(len(page.Results))
+				if !more {
+					return
+				}
+				filters.Offset = offset
+			}
+		}
+
+		// Modo scan: sin límite de offset, el orden no coincide con el de offset
+		filters.Scan = true
+		filters.Offset = 0
+		filters.ScrollID = ""
+		for {
+			page, err := c.SearchSellerItems(ctx, sellerID, filters, accessToken)
+			if err != nil {
+				yield("", err)
+				return
+			}
+			if len(page.Results) == 0 {
+				return
+			}
+			for _, id := range page.Results {
+				if seen[id] {
+					continue
+				}
+				if !yield(id, nil) {
+					return
+				}
+			}
+			if page.ScrollID == "" {
+				return
+			}
+			filters.ScrollID = page.ScrollID
+		}
+	}
+}
+
+// SellerItems itera los IDs de ítems de un vendedor usando el Client por defecto
+func SellerItems(ctx context.Context, sellerID int64, filters SellerItemsFilters, accessToken string) iter.Seq2[string, error] {
+	return defaultClient.SellerItems(ctx, sellerID, filters, accessToken)
+}
@@ -0,0 +1,59 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	nethttp "net/http"
+	"net/http/httptest"
+	"slices"
+	"sync"
+	"testing"
+)
+
+func TestSellerItemsSwitchesToScan(t *testing.T) {
+	var mu sync.Mutex
+	var queries []string
+	srv := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		mu.Lock()
+		queries = append(queries, r.URL.RawQuery)
+		mu.Unlock()
+
+		query := r.URL.Query()
+		if query.Get("search_type") == "scan" {
+			switch query.Get("scroll_id") {
+			case "":
+				fmt.Fprint(w, `{"results":["MLM1","MLM3"],"paging":{"total":1500,"limit":2},"scroll_id":"s1"}`)
+			case "s1":
+				fmt.Fprint(w, `{"results":["MLM4"],"paging":{"total":1500,"limit":2},"scroll_id":"s2"}`)
+			default:
+				fmt.Fprint(w, `{"results":[],"paging":{"total":1500,"limit":2}}`)
+			}
+			return
+		}
+		fmt.Fprint(w, `{"results":["MLM1","MLM2"],"paging":{"total":1500,"offset":0,"limit":2}}`)
+	}))
+	defer srv.Close()
+
+	client := NewClient(WithBaseURL(srv.URL))
+	var got []string
+	for id, err := range client.SellerItems(context.Background(), 1, SellerItemsFilters{Status: "active", Limit: 2}, "") {
+		if err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, id)
+	}
+
+	// Con total mayor a 1000 se pasa a scan sin repetir los IDs ya entregados por offset
+	if want := []string{"MLM1", "MLM2", "MLM3", "MLM4"}; !slices.Equal(got, want) {
+		t.Errorf("IDs = %v, want %v", got, want)
+	}
+	want := []string{
+		"limit=2&status=active",
+		"limit=2&search_type=scan&status=active",
+		"limit=2&scroll_id=s1&search_type=scan&status=active",
+		"limit=2&scroll_id=s2&search_type=scan&status=active",
+	}
+	if !slices.Equal(queries, want) {
+		t.Errorf("queries = %v, want %v", queries, want)
+	}
+}
@@ -0,0 +1,173 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"io"
+	nethttp "net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tidyrocks/mercado-libre-go-sdk/internal/http"
+)
+
+const shipmentsEndpoint = "/shipments"
+const shipmentLabelsEndpoint = "/shipment_labels"
+
+// LabelFormat representa el formato de descarga de etiquetas de envío
+type LabelFormat string
+
+const (
+	LabelFormatPDF LabelFormat = "pdf"  // PDF listo para imprimir
+	LabelFormatZPL LabelFormat = "zpl2" // ZPL para impresoras térmicas (se entrega en un .zip)
+)
+
+// Shipment representa un envío de Mercado Envíos
+type Shipment struct {
+	ID            int64                 `json:"id"`             // ID del envío
+	Mode          string                `json:"mode"`           // Modo: "me1", "me2", "custom"
+	LogisticType  string                `json:"logistic_type"`  // Tipo logístico: "drop_off", "cross_docking", "fulfillment", etc.
+	Status        string                `json:"status"`         // Estado: "pending", "ready_to_ship", "shipped", "delivered", etc.
+	Substatus     string                `json:"substatus"`      // Subestado (ej. "printed", "ready_to_print")
+	SiteID        string                `json:"site_id"`        // ID del sitio
+	SenderID      int64                 `json:"sender_id"`      // ID del vendedor
+	ReceiverID    int64                 `json:"receiver_id"`    // ID del comprador
+	OrderCost     float64               `json:"order_cost"`     // Monto de la orden
+	BaseCost      float64               `json:"base_cost"`      // Costo base del envío
+	DateCreated   time.Time             `json:"date_created"`   // Fecha de creación
+	LastUpdated   time.Time             `json:"last_updated"`   // Fecha de última actualización
+	StatusHistory ShipmentStatusDates   `json:"status_history"` // Fechas de cada cambio de estado
+	ShippingItems []ShipmentItemSummary `json:"shipping_items"` // Ítems incluidos
+	// Campos opcionales (punteros)
+	OrderID         *int64           `json:"order_id,omitempty"`         // ID de la orden
+	TrackingNumber  *string          `json:"tracking_number,omitempty"`  // Número de seguimiento
+	TrackingMethod  *string          `json:"tracking_method,omitempty"`  // Transportista
+	ServiceID       *int64           `json:"service_id,omitempty"`       // ID del servicio de envío
+	ReceiverAddress *ShipmentAddress `json:"receiver_address,omitempty"` // Dirección de entrega
+}
+
+// ShipmentStatusDates representa las fechas en que el envío pasó por cada estado
+type ShipmentStatusDates struct {
+	DateHandling     *time.Time `json:"date_handling,omitempty"`      // Pago acreditado, en preparación
+	DateReadyToShip  *time.Time `json:"date_ready_to_ship,omitempty"` // Listo para despachar
+	DateShipped      *time.Time `json:"date_shipped,omitempty"`       // Despachado
+	DateFirstVisit   *time.Time `json:"date_first_visit,omitempty"`   // Primera visita del transportista
+	DateDelivered    *time.Time `json:"date_delivered,omitempty"`     // Entregado
+	DateNotDelivered *time.Time `json:"date_not_delivered,omitempty"` // No entregado
+	DateReturned     *time.Time `json:"date_returned,omitempty"`      // Devuelto
+	DateCancelled    *time.Time `json:"date_cancelled,omitempty"`     // Cancelado
+}
+
+// ShipmentItemSummary representa un ítem dentro del detalle del envío
+type ShipmentItemSummary struct {
+	ID          string `json:"id"`          // ID del ítem
+	Description string `json:"description"` // Título del ítem
+	Quantity    int    `json:"quantity"`    // Cantidad
+	// Campos opcionales (punteros)
+	Dimensions *string `json:"dimensions,omitempty"` // Dimensiones del paquete (ej. "10x10x10,500")
+}
+
+// ShipmentAddress representa la dirección de entrega de un envío
+type ShipmentAddress struct {
+	AddressLine  string       `json:"address_line"`  // Calle y número
+	StreetName   string       `json:"street_name"`   // Calle
+	StreetNumber string       `json:"street_number"` // Número
+	ZipCode      string       `json:"zip_code"`      // Código postal
+	City         AddressPlace `json:"city"`          // Ciudad
+	State        AddressPlace `json:"state"`         // Estado o provincia
+	Country      AddressPlace `json:"country"`       // País
+	// Campos opcionales (punteros)
+	Comment       *string `json:"comment,omitempty"`        // Referencias de entrega
+	ReceiverName  *string `json:"receiver_name,omitempty"`  // Nombre de quien recibe
+	ReceiverPhone *string `json:"receiver_phone,omitempty"` // Teléfono de quien recibe
+}
+
+// AddressPlace representa una ciudad, estado o país de una dirección
+type AddressPlace struct {
+	ID   string `json:"id"`   // ID de la ubicación
+	Name string `json:"name"` // Nombre
+}
+
+// ShipmentItem representa un ítem de /shipments/{id}/items
+type ShipmentItem struct {
+	ItemID   string `json:"item_id"`  // ID del ítem
+	Quantity int    `json:"quantity"` // Cantidad
+	OrderID  int64  `json:"order_id"` // ID de la orden
+	// Campos opcionales (punteros)
+	VariationID *int64  `json:"variation_id,omitempty"` // ID de la variación
+	Description *string `json:"description,omitempty"`  // Título del ítem
+}
+
+// ShipmentStatusEvent representa un cambio de estado en el historial de un envío
+type ShipmentStatusEvent struct {
+	Status    string    `json:"status"`    // Estado
+	Substatus string    `json:"substatus"` // Subestado
+	Date      time.Time `json:"date"`      // Fecha del cambio
+}
+
+// GetShipment obtiene un envío por su ID
+func (c *Client) GetShipment(ctx context.Context, shipmentID int64, accessToken string) (Shipment, error) {
+	url := c.endpoint("%s/%d", shipmentsEndpoint, shipmentID)
+	var shipment Shipment
+	err := http.DoGetJSON(ctx, c, url, accessToken, &shipment)
+	return shipment, err
+}
+
+// GetShipment obtiene un envío usando el Client por defecto
+func GetShipment(ctx context.Context, shipmentID int64, accessToken string) (Shipment, error) {
+	return defaultClient.GetShipment(ctx, shipmentID, accessToken)
+}
+
+// GetShipmentItems obtiene los ítems (con variación y orden) incluidos en un envío
+func (c *Client) GetShipmentItems(ctx context.Context, shipmentID int64, accessToken string) ([]ShipmentItem, error) {
+	url := c.endpoint("%s/%d/items", shipmentsEndpoint, shipmentID)
+	var items []ShipmentItem
+	err := http.DoGetJSON(ctx, c, url, accessToken, &items)
+	return items, err
+}
+
+// GetShipmentItems obtiene los ítems de un envío usando el Client por defecto
+func GetShipmentItems(ctx context.Context, shipmentID int64, accessToken string) ([]ShipmentItem, error) {
+	return defaultClient.GetShipmentItems(ctx, shipmentID, accessToken)
+}
+
+// GetShipmentHistory obtiene el historial de estados de un envío
+func (c *Client) GetShipmentHistory(ctx context.Context, shipmentID int64, accessToken string) ([]ShipmentStatusEvent, error) {
+	url := c.endpoint("%s/%d/history", shipmentsEndpoint, shipmentID)
+	var history []ShipmentStatusEvent
+	err := http.DoGetJSON(ctx, c, url, accessToken, &history)
+	return history, err
+}
+
+// GetShipmentHistory obtiene el historial de un envío usando el Client por defecto
+func GetShipmentHistory(ctx context.Context, shipmentID int64, accessToken string) ([]ShipmentStatusEvent, error) {
+	return defaultClient.GetShipmentHistory(ctx, shipmentID, accessToken)
+}
+
+// DownloadShippingLabels descarga las etiquetas de envíos me2 listos para despachar.
+// Devuelve el archivo sin procesar (PDF, o un .zip con los ZPL); el llamador debe cerrarlo.
+func (c *Client) DownloadShippingLabels(ctx context.Context, shipmentIDs []int64, format LabelFormat, accessToken string) (io.ReadCloser, error) {
+	if len(shipmentIDs) == 0 {
+		return nil, errors.New("se requiere al menos un shipment ID")
+	}
+	ids := make([]string, 0, len(shipmentIDs))
+	for _, id := range shipmentIDs {
+		ids = append(ids, strconv.FormatInt(id, 10))
+	}
+
+	params := url.Values{}
+	params.Set("shipment_ids", strings.Join(ids, ","))
+	params.Set("response_type", string(format))
+	u, err := http.WithParams(c.endpoint(shipmentLabelsEndpoint), params)
+	if err != nil {
+		return nil, err
+	}
+	return http.DoStream(ctx, c, nethttp.MethodGet, u, accessToken, "", nil)
+}
+
+// DownloadShippingLabels descarga etiquetas de envío usando el Client por defecto
+func DownloadShippingLabels(ctx context.Context, shipmentIDs []int64, format LabelFormat, accessToken string) (io.ReadCloser, error) {
+	return defaultClient.DownloadShippingLabels(ctx, shipmentIDs, format, accessToken)
+}
@@ -0,0 +1,178 @@
+package api
+
+import (
+	"context"
+	"iter"
+	"net/url"
+	"strconv"
+
+	"github.com/tidyrocks/mercado-libre-go-sdk/internal/http"
+)
+
+// maxSiteSearchOffset es el offset máximo permitido por /sites/{id}/search.
+const maxSiteSearchOffset = 1000
+
+// defaultSiteSearchLimit es el tamaño de página usado por el iterador de búsqueda.
+const defaultSiteSearchLimit = 50
+
+// SiteSearchQuery representa los parámetros de búsqueda pública en un sitio
+type SiteSearchQuery struct {
+	Query      string            // Texto a buscar (q)
+	CategoryID string            // Categoría (category)
+	SellerID   int64             // Vendedor (seller_id)
+	Nickname   string            // Apodo del vendedor (nickname)
+	Sort       string            // Orden (ej. "price_asc"); ver SiteSearch.AvailableSorts
+	Filters    map[string]string // Filtros por ID (ej. "condition": "new", "shipping_cost": "free")
+	Limit      int               // Tamaño de página (máximo 50)
+	Offset     int               // Desplazamiento (máximo 1000)
+}
+
+// SiteSearch representa una página de resultados de /sites/{id}/search
+type SiteSearch struct {
+	SiteID           string         `json:"site_id"`           // ID del sitio
+	Query            string         `json:"query"`             // Texto buscado
+	Paging           Paging         `json:"paging"`            // Paginación
+	Results          []SearchResult `json:"results"`           // Ítems encontrados
+	Sort             SearchSort     `json:"sort"`              // Orden aplicado
+	AvailableSorts   []SearchSort   `json:"available_sorts"`   // Órdenes disponibles
+	Filters          []SearchFilter `json:"filters"`           // Filtros aplicados
+	AvailableFilters []SearchFilter `json:"available_filters"` // Filtros disponibles (facetas)
+}
+
+// SearchResult representa un ítem dentro de los resultados de búsqueda
+type SearchResult struct {
+	ID                string         `json:"id"`                 // ID del ítem
+	Title             string         `json:"title"`              // Título
+	Condition         string         `json:"condition"`          // Condición
+	Thumbnail         string         `json:"thumbnail"`          // URL de la miniatura
+	Permalink         string         `json:"permalink"`          // URL de la publicación
+	CurrencyID        string         `json:"currency_id"`        // Moneda
+	Price             float64        `json:"price"`              // Precio
+	AvailableQuantity int            `json:"available_quantity"` // Cantidad disponible (aproximada)
+	BuyingMode        string         `json:"buying_mode"`        // Modo de compra
+	ListingTypeID     string         `json:"listing_type_id"`    // Tipo de publicación
+	CategoryID        string         `json:"category_id"`        // Categoría
+	Seller            SearchSeller   `json:"seller"`             // Vendedor
+	Shipping          SearchShipping `json:"shipping"`           // Envío
+	// Arrays y slices
+	Attrs []Attr `json:"attributes"` // Atributos principales
+	// Campos opcionales (punteros)
+	OriginalPrice    *float64 `json:"original_price,omitempty"`     // Precio antes del descuento
+	SoldQuantity     *int     `json:"sold_quantity,omitempty"`      // Cantidad vendida
+	DomainID         *string  `json:"domain_id,omitempty"`          // Dominio de catálogo
+	CatalogProductID *string  `json:"catalog_product_id,omitempty"` // Producto de catálogo
+	OfficialStoreID  *int64   `json:"official_store_id,omitempty"`  // Tienda oficial
+}
+
+// SearchSeller representa al vendedor de un resultado de búsqueda
+type SearchSeller struct {
+	ID       int64  `json:"id"`       // ID del vendedor
+	Nickname string `json:"nickname"` // Apodo
+}
+
+// SearchShipping representa el envío de un resultado de búsqueda
+type SearchShipping struct {
+	FreeShipping bool   `json:"free_shipping"` // Envío gratis
+	Mode         string `json:"mode"`          // Modo: "me2", "custom", etc.
+	LogisticType string `json:"logistic_type"` // Tipo logístico
+}
+
+// SearchSort representa un criterio de orden de la búsqueda
+type SearchSort struct {
+	ID   string `json:"id"`   // ID del orden (ej. "relevance", "price_asc")
+	Name string `json:"name"` // Nombre
+}
+
+// SearchFilter representa un filtro aplicado o disponible en la búsqueda
+type SearchFilter struct {
+	ID     string              `json:"id"`     // ID del filtro (se usa como clave en SiteSearchQuery.Filters)
+	Name   string              `json:"name"`   // Nombre
+	Type   string              `json:"type"`   // Tipo: "text", "boolean", "range", etc.
+	Values []SearchFilterValue `json:"values"` // Valores
+}
+
+// SearchFilterValue representa un valor de un filtro de búsqueda
+type SearchFilterValue struct {
+	ID   string `json:"id"`   // ID del valor
+	Name string `json:"name"` // Nombre
+	// Campos opcionales (punteros)
+	Results      *int           `json:"results,omitempty"`        // Resultados con este valor (filtros disponibles)
+	PathFromRoot []CategoryPath `json:"path_from_root,omitempty"` // Ruta de la categoría (filtro category)
+}
+
+// params convierte la búsqueda en query parameters.
+func (q SiteSearchQuery) params() url.Values {
+	params := url.Values{}
+	for id, value := range q.Filters {
+		params.Set(id, value)
+	}
+	if q.Query != "" {
+		params.Set("q", q.Query)
+	}
+	if q.CategoryID != "" {
+		params.Set("category", q.CategoryID)
+	}
+	if q.SellerID != 0 {
+		params.Set("seller_id", strconv.FormatInt(q.SellerID, 10))
+	}
+	if q.Nickname != "" {
+		params.Set("nickname", q.Nickname)
+	}
+	if q.Sort != "" {
+		params.Set("sort", q.Sort)
+	}
+	if q.Limit > 0 {
+		params.Set("limit", strconv.Itoa(q.Limit))
+	}
+	if q.Offset > 0 {
+		params.Set("offset", strconv.Itoa(q.Offset))
+	}
+	return params
+}
+
+// SearchSite obtiene una página de resultados de la búsqueda pública de un sitio
+func (c *Client) SearchSite(ctx context.Context, siteID string, query SiteSearchQuery, accessToken string) (SiteSearch, error) {
+	url := c.endpoint("%s/%s/search", sitesEndpoint, siteID)
+	var search SiteSearch
+	err := http.DoGetJSONWithParams(ctx, c, url, accessToken, query.params(), &search)
+	return search, err
+}
+
+// SearchSite busca en un sitio usando el Client por defecto
+func SearchSite(ctx context.Context, siteID string, query SiteSearchQuery, accessToken string) (SiteSearch, error) {
+	return defaultClient.SearchSite(ctx, siteID, query, accessToken)
+}
+
+// SiteSearchResults itera los resultados de una búsqueda pública, paginando por offset hasta
+// el límite de 1000 que impone la API. La iteración termina en el primer error.
+func (c *Client) SiteSearchResults(ctx context.Context, siteID string, query SiteSearchQuery, accessToken string) iter.Seq2[SearchResult, error] {
+	return func(yield func(SearchResult, error) bool) {
+		query := query
+		if query.Limit <= 0 {
+			query.Limit = defaultSiteSearchLimit
+		}
+		for {
+			page, err := c.SearchSite(ctx, siteID, query, accessToken)
+			if err != nil {
+				yield(SearchResult{}, err)
+				return
+			}
+			for _, result := range page.Results {
+				if !yield(result, nil) {
+					return
+				}
+			}
+			offset, more := page.Paging.next(len(page.Results))
+			if !more || offset >= maxSiteSearchOffset {
+				return
+			}
+			query.Offset = offset
+			query.Limit = min(query.Limit, maxSiteSearchOffset-offset)
+		}
+	}
+}
+
+// SiteSearchResults itera los resultados de una búsqueda usando el Client por defecto
+func SiteSearchResults(ctx context.Context, siteID string, query SiteSearchQuery, accessToken string) iter.Seq2[SearchResult, error] {
+	return defaultClient.SiteSearchResults(ctx, siteID, query, accessToken)
+}
@@ -0,0 +1,52 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	nethttp "net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestSiteSearchResultsStopsAtMaxOffset(t *testing.T) {
+	var mu sync.Mutex
+	var pages []string
+	srv := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		mu.Lock()
+		pages = append(pages, fmt.Sprintf("%d/%d", offset, limit))
+		mu.Unlock()
+
+		results := make([]string, limit)
+		for i := range results {
+			results[i] = fmt.Sprintf(`{"id":"MLM%d"}`, offset+i)
+		}
+		fmt.Fprintf(w, `{"results":[%s],"paging":{"total":5000,"offset":%d,"limit":%d}}`, strings.Join(results, ","), offset, limit)
+	}))
+	defer srv.Close()
+
+	client := NewClient(WithBaseURL(srv.URL))
+	count := 0
+	for result, err := range client.SiteSearchResults(context.Background(), "MLM", SiteSearchQuery{Query: "ipod", Limit: 40, Offset: 950}, "") {
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := fmt.Sprintf("MLM%d", 950+count); result.ID != want {
+			t.Errorf("resultado %s, want %s", result.ID, want)
+		}
+		count++
+	}
+	// Aunque total es 5000, la búsqueda no admite offsets de 1000 o más
+	if count != 50 {
+		t.Errorf("%d resultados, want 50", count)
+	}
+	// La última página se acorta para no pasar del offset máximo
+	if want := []string{"950/40", "990/10"}; !slices.Equal(pages, want) {
+		t.Errorf("páginas = %v, want %v", pages, want)
+	}
+}
@@ -6,7 +6,7 @@ import (
 	"github.com/tidyrocks/mercado-libre-go-sdk/internal/http"
 )
 
-const sitesEndpoint = "https://api.mercadolibre.com/sites"
+const sitesEndpoint = "/sites"
 
 // Site representa un sitio de Mercado Libre (país)
 type Site struct {
@@ -17,8 +17,13 @@ type Site struct {
 }
 
 // GetSites obtiene la lista de todos los sitios disponibles en Mercado Libre
-func GetSites(ctx context.Context, accessToken string) ([]Site, error) {
+func (c *Client) GetSites(ctx context.Context, accessToken string) ([]Site, error) {
 	var sites []Site
-	err := http.DoGetJSON(ctx, sitesEndpoint, accessToken, &sites)
+	err := http.DoGetJSON(ctx, c, c.endpoint(sitesEndpoint), accessToken, &sites)
 	return sites, err
+}
+
+// GetSites obtiene la lista de sitios usando el Client por defecto
+func GetSites(ctx context.Context, accessToken string) ([]Site, error) {
+	return defaultClient.GetSites(ctx, accessToken)
 }
\ No newline at end of file
@@ -0,0 +1,187 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// defaultRefreshLeeway es el margen antes de la expiración en que se renueva el token.
+const defaultRefreshLeeway = 5 * time.Minute
+
+// TokenSource entrega un Token vigente para autenticar peticiones.
+// Equivale en espíritu a oauth2.TokenSource, pero recibe el contexto de la petición.
+type TokenSource interface {
+	Token(ctx context.Context) (Token, error)
+}
+
+// TokenStore persiste tokens por vendedor (Token.UserID).
+// Se usa para guardar el refresh token rotado en cada renovación.
+type TokenStore interface {
+	Load(ctx context.Context, userID int64) (Token, error) // Devuelve ErrTokenNotFound si no existe
+	Save(ctx context.Context, token Token) error
+}
+
+// ErrTokenNotFound indica que un TokenStore no tiene token para el usuario solicitado.
+var ErrTokenNotFound = errors.New("token no encontrado")
+
+// tokenRefresher es implementado por las fuentes que permiten forzar una renovación (ej. tras un 401).
+type tokenRefresher interface {
+	forceRefresh(ctx context.Context, stale string) (Token, error)
+}
+
+// RefreshingTokenSource cachea un Token y lo renueva con RefreshAccessToken antes de que expire.
+// Las renovaciones concurrentes se deduplican: solo una petición de refresh está en vuelo a la vez.
+type RefreshingTokenSource struct {
+	client       *Client
+	clientID     string
+	clientSecret string
+	store        TokenStore
+	leeway       time.Duration
+
+	sem   chan struct{} // Semáforo de 1 que protege token y serializa las renovaciones
+	token Token
+}
+
+// TokenSourceOption configura un RefreshingTokenSource.
+type TokenSourceOption func(*RefreshingTokenSource)
+
+// WithTokenStore persiste en store cada token renovado y lo consulta antes de renovar,
+// por si otro proceso ya rotó el refresh token.
+func WithTokenStore(store TokenStore) TokenSourceOption {
+	return func(s *RefreshingTokenSource) {
+		s.store = store
+	}
+}
+
+// WithRefreshLeeway cambia el margen antes de la expiración en que se renueva (default 5 minutos).
+func WithRefreshLeeway(leeway time.Duration) TokenSourceOption {
+	return func(s *RefreshingTokenSource) {
+		s.leeway = leeway
+	}
+}
+
+// WithTokenClient usa client para las llamadas a /oauth/token (default: el Client por defecto).
+func WithTokenClient(client *Client) TokenSourceOption {
+	return func(s *RefreshingTokenSource) {
+		s.client = client
+	}
+}
+
+// NewRefreshingTokenSource crea una fuente que parte de token (basta con RefreshToken y UserID)
+// y lo renueva con las credenciales de la aplicación.
+func NewRefreshingTokenSource(clientID, clientSecret string, token Token, opts ...TokenSourceOption) *RefreshingTokenSource {
+	s := &RefreshingTokenSource{
+		client:       defaultClient,
+		clientID:     clientID,
+		clientSecret: clientSecret,
+		leeway:       defaultRefreshLeeway,
+		sem:          make(chan struct{}, 1),
+		token:        token,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// lock adquiere el semáforo respetando la cancelación de ctx.
+func (s *RefreshingTokenSource) lock(ctx context.Context) error {
+	select {
+	case s.sem <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (s *RefreshingTokenSource) unlock() {
+	<-s.sem
+}
+
+// Token devuelve el token cacheado, renovándolo si falta o expira dentro del margen configurado.
+func (s *RefreshingTokenSource) Token(ctx context.Context) (Token, error) {
+	if err := s.lock(ctx); err != nil {
+		return Token{}, err
+	}
+	defer s.unlock()
+
+	if s.token.AccessToken != "" && !s.token.expiresWithin(s.leeway) {
+		return s.token, nil
+	}
+	return s.refresh(ctx)
+}
+
+// Refresh fuerza la renovación del token aunque siga vigente.
+func (s *RefreshingTokenSource) Refresh(ctx context.Context) (Token, error) {
+	if err := s.lock(ctx); err != nil {
+		return Token{}, err
+	}
+	defer s.unlock()
+
+	return s.refresh(ctx)
+}
+
+// set reemplaza el token cacheado.
+func (s *RefreshingTokenSource) set(ctx context.Context, token Token) error {
+	if err := s.lock(ctx); err != nil {
+		return err
+	}
+	defer s.unlock()
+
+	s.token = token
+	return nil
+}
+
+// forceRefresh renueva solo si el token actual sigue siendo stale; si otra goroutine
+// ya lo renovó, devuelve el nuevo sin otra llamada a la API.
+func (s *RefreshingTokenSource) forceRefresh(ctx context.Context, stale string) (Token, error) {
+	if err := s.lock(ctx); err != nil {
+		return Token{}, err
+	}
+	defer s.unlock()
+
+	if s.token.AccessToken != "" && s.token.AccessToken != stale {
+		return s.token, nil
+	}
+	return s.refresh(ctx)
+}
+
+// refresh renueva el token; debe llamarse con el semáforo adquirido.
+func (s *RefreshingTokenSource) refresh(ctx context.Context) (Token, error) {
+	if s.store != nil && s.token.UserID != 0 {
+		stored, err := s.store.Load(ctx, s.token.UserID)
+		if err != nil && !errors.Is(err, ErrTokenNotFound) {
+			return Token{}, err
+		}
+		// Otro proceso pudo haber rotado el token: se adopta si es más reciente y vigente
+		if err == nil && stored.Expiry.After(s.token.Expiry) && !stored.expiresWithin(s.leeway) {
+			s.token = stored
+			return s.token, nil
+		}
+		if err == nil && stored.RefreshToken != "" {
+			s.token.RefreshToken = stored.RefreshToken
+		}
+	}
+
+	token, err := s.client.RefreshAccessToken(ctx, s.clientID, s.clientSecret, s.token.RefreshToken)
+	if err != nil {
+		return Token{}, err
+	}
+	if token.UserID == 0 {
+		token.UserID = s.token.UserID
+	}
+	if token.RefreshToken == "" {
+		token.RefreshToken = s.token.RefreshToken
+	}
+
+	// El refresh token anterior ya no es válido: se conserva el nuevo aunque falle la persistencia
+	s.token = token
+	if s.store != nil {
+		if err := s.store.Save(ctx, token); err != nil {
+			return Token{}, fmt.Errorf("guardando token renovado: %w", err)
+		}
+	}
+	return s.token, nil
+}
@@ -0,0 +1,94 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"io"
+	nethttp "net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRefreshOn401IsDeduplicated(t *testing.T) {
+	var refreshes, requests atomic.Int32
+	srv := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		if r.URL.Path == tokenEndpoint {
+			io.Copy(io.Discard, r.Body)
+			refreshes.Add(1)
+			// Demora la renovación para que todas las peticiones reciban el 401 antes de que termine
+			time.Sleep(50 * time.Millisecond)
+			fmt.Fprint(w, `{"access_token":"new","refresh_token":"r2","expires_in":21600,"user_id":1}`)
+			return
+		}
+		requests.Add(1)
+		if r.Header.Get("Authorization") != "Bearer new" {
+			w.WriteHeader(nethttp.StatusUnauthorized)
+			fmt.Fprint(w, `{"message":"invalid access token","error":"unauthorized","status":401}`)
+			return
+		}
+		fmt.Fprint(w, `{"id":"MLM1"}`)
+	}))
+	defer srv.Close()
+
+	tokenClient := NewClient(WithBaseURL(srv.URL))
+	source := NewRefreshingTokenSource("app", "secret",
+		Token{AccessToken: "old", RefreshToken: "r1", UserID: 1, Expiry: time.Now().Add(time.Hour)},
+		WithTokenClient(tokenClient))
+	client := NewClient(WithBaseURL(srv.URL), WithTokenSource(source))
+
+	const concurrent = 10
+	var wg sync.WaitGroup
+	errs := make(chan error, concurrent)
+	for range concurrent {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := client.GetItem(context.Background(), "MLM1", "")
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Errorf("GetItem: %v", err)
+		}
+	}
+	if got := refreshes.Load(); got != 1 {
+		t.Errorf("renovaciones = %d, want 1", got)
+	}
+	if got := requests.Load(); got != 2*concurrent {
+		t.Errorf("peticiones = %d, want %d (401 + reintento por goroutine)", got, 2*concurrent)
+	}
+	if token, _ := source.Token(context.Background()); token.RefreshToken != "r2" {
+		t.Errorf("refresh token = %q, want r2", token.RefreshToken)
+	}
+}
+
+func TestRefreshSavesRotatedToken(t *testing.T) {
+	srv := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		io.Copy(io.Discard, r.Body)
+		fmt.Fprint(w, `{"access_token":"new","refresh_token":"r2","expires_in":21600,"user_id":1}`)
+	}))
+	defer srv.Close()
+
+	store := NewMemoryTokenStore()
+	source := NewRefreshingTokenSource("app", "secret", Token{RefreshToken: "r1", UserID: 1},
+		WithTokenClient(NewClient(WithBaseURL(srv.URL))), WithTokenStore(store))
+
+	token, err := source.Token(context.Background())
+	if err != nil {
+		t.Fatalf("Token: %v", err)
+	}
+	if token.AccessToken != "new" || token.Expiry.IsZero() {
+		t.Errorf("token = %+v", token)
+	}
+	stored, err := store.Load(context.Background(), 1)
+	if err != nil || stored.RefreshToken != "r2" {
+		t.Errorf("store = %+v, %v; want refresh token r2", stored, err)
+	}
+}
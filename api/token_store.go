@@ -0,0 +1,220 @@
+package api
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// MemoryTokenStore guarda tokens en memoria. Útil para pruebas y procesos de vida corta.
+type MemoryTokenStore struct {
+	mu     sync.RWMutex
+	tokens map[int64]Token
+}
+
+// NewMemoryTokenStore crea un MemoryTokenStore vacío.
+func NewMemoryTokenStore() *MemoryTokenStore {
+	return &MemoryTokenStore{tokens: make(map[int64]Token)}
+}
+
+// Load devuelve el token del vendedor userID.
+func (s *MemoryTokenStore) Load(ctx context.Context, userID int64) (Token, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	token, ok := s.tokens[userID]
+	if !ok {
+		return Token{}, ErrTokenNotFound
+	}
+	return token, nil
+}
+
+// Save guarda token bajo token.UserID.
+func (s *MemoryTokenStore) Save(ctx context.Context, token Token) error {
+	if token.UserID == 0 {
+		return errors.New("token sin user_id")
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.tokens[token.UserID] = token
+	return nil
+}
+
+// FileTokenStore guarda un archivo cifrado (AES-GCM) por vendedor dentro de un directorio.
+// Cada escritura va a un archivo temporal que se renombra, por lo que nunca queda un token a medias.
+type FileTokenStore struct {
+	dir  string
+	aead cipher.AEAD
+	mu   sync.Mutex
+}
+
+// NewFileTokenStore crea un FileTokenStore en dir cifrando con key (16, 24 o 32 bytes para AES-128/192/256).
+func NewFileTokenStore(dir string, key []byte) (*FileTokenStore, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, err
+	}
+	return &FileTokenStore{dir: dir, aead: aead}, nil
+}
+
+// path devuelve el archivo del vendedor userID.
+func (s *FileTokenStore) path(userID int64) string {
+	return filepath.Join(s.dir, strconv.FormatInt(userID, 10)+".token")
+}
+
+// Load descifra el token del vendedor userID.
+func (s *FileTokenStore) Load(ctx context.Context, userID int64) (Token, error) {
+	data, err := os.ReadFile(s.path(userID))
+	if errors.Is(err, os.ErrNotExist) {
+		return Token{}, ErrTokenNotFound
+	}
+	if err != nil {
+		return Token{}, err
+	}
+
+	nonceSize := s.aead.NonceSize()
+	if len(data) < nonceSize {
+		return Token{}, fmt.Errorf("archivo de token corrupto: %s", s.path(userID))
+	}
+	plain, err := s.aead.Open(nil, data[:nonceSize], data[nonceSize:], nil)
+	if err != nil {
+		return Token{}, fmt.Errorf("descifrando token de %d: %w", userID, err)
+	}
+
+	var token Token
+	err = json.Unmarshal(plain, &token)
+	return token, err
+}
+
+// Save cifra y guarda token bajo token.UserID de forma atómica.
+func (s *FileTokenStore) Save(ctx context.Context, token Token) error {
+	if token.UserID == 0 {
+		return errors.New("token sin user_id")
+	}
+	plain, err := json.Marshal(token)
+	if err != nil {
+		return err
+	}
+	nonce := make([]byte, s.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return err
+	}
+	data := s.aead.Seal(nonce, nonce, plain, nil)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tmp, err := os.CreateTemp(s.dir, ".token-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), s.path(token.UserID))
+}
+
+// SQLPlaceholder define el estilo de parámetros y de upsert del driver SQL.
+type SQLPlaceholder int
+
+const (
+	QuestionPlaceholder SQLPlaceholder = iota // "?" con ON CONFLICT (SQLite)
+	DollarPlaceholder                         // "$1" con ON CONFLICT (PostgreSQL)
+	MySQLPlaceholder                          // "?" con ON DUPLICATE KEY UPDATE (MySQL, MariaDB)
+)
+
+// SQLTokenStore guarda tokens en una tabla SQL con columnas
+// user_id BIGINT PRIMARY KEY, token TEXT (JSON) y updated_at TIMESTAMP.
+type SQLTokenStore struct {
+	db          *sql.DB
+	table       string
+	placeholder SQLPlaceholder
+}
+
+// NewSQLTokenStore crea un SQLTokenStore sobre table. El nombre de la tabla no se escapa:
+// debe provenir de configuración, nunca de input de usuarios.
+func NewSQLTokenStore(db *sql.DB, table string, placeholder SQLPlaceholder) *SQLTokenStore {
+	return &SQLTokenStore{db: db, table: table, placeholder: placeholder}
+}
+
+// arg devuelve el placeholder del parámetro n (desde 1).
+func (s *SQLTokenStore) arg(n int) string {
+	if s.placeholder == DollarPlaceholder {
+		return "$" + strconv.Itoa(n)
+	}
+	return "?"
+}
+
+// CreateTable crea la tabla si no existe.
+func (s *SQLTokenStore) CreateTable(ctx context.Context) error {
+	query := fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s (user_id BIGINT PRIMARY KEY, token TEXT NOT NULL, updated_at TIMESTAMP NOT NULL)", s.table)
+	_, err := s.db.ExecContext(ctx, query)
+	return err
+}
+
+// Load devuelve el token del vendedor userID.
+func (s *SQLTokenStore) Load(ctx context.Context, userID int64) (Token, error) {
+	query := fmt.Sprintf("SELECT token FROM %s WHERE user_id = %s", s.table, s.arg(1))
+	var data string
+	err := s.db.QueryRowContext(ctx, query, userID).Scan(&data)
+	if errors.Is(err, sql.ErrNoRows) {
+		return Token{}, ErrTokenNotFound
+	}
+	if err != nil {
+		return Token{}, err
+	}
+
+	var token Token
+	err = json.Unmarshal([]byte(data), &token)
+	return token, err
+}
+
+// Save inserta o reemplaza token bajo token.UserID con un único upsert atómico,
+// de modo que dos guardados concurrentes del mismo vendedor no chocan en la clave primaria.
+func (s *SQLTokenStore) Save(ctx context.Context, token Token) error {
+	if token.UserID == 0 {
+		return errors.New("token sin user_id")
+	}
+	data, err := json.Marshal(token)
+	if err != nil {
+		return err
+	}
+
+	query := fmt.Sprintf("INSERT INTO %s (user_id, token, updated_at) VALUES (%s, %s, %s) %s",
+		s.table, s.arg(1), s.arg(2), s.arg(3), s.upsertClause())
+	_, err = s.db.ExecContext(ctx, query, token.UserID, string(data), time.Now().UTC())
+	return err
+}
+
+// upsertClause devuelve la cláusula que actualiza la fila existente según el motor.
+func (s *SQLTokenStore) upsertClause() string {
+	if s.placeholder == MySQLPlaceholder {
+		return "ON DUPLICATE KEY UPDATE token = VALUES(token), updated_at = VALUES(updated_at)"
+	}
+	return "ON CONFLICT (user_id) DO UPDATE SET token = excluded.token, updated_at = excluded.updated_at"
+}
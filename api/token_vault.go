@@ -0,0 +1,65 @@
+package api
+
+import (
+	"context"
+	"sync"
+)
+
+// TokenVault administra los tokens de varios vendedores sobre un TokenStore compartido.
+// Mantiene un RefreshingTokenSource por vendedor para que todos los Client de un mismo
+// vendedor compartan el token y deduplicen las renovaciones.
+type TokenVault struct {
+	clientID     string
+	clientSecret string
+	store        TokenStore
+	opts         []TokenSourceOption
+
+	mu      sync.Mutex
+	sources map[int64]*RefreshingTokenSource
+}
+
+// NewTokenVault crea un TokenVault con las credenciales de la aplicación.
+// opts se aplican a cada RefreshingTokenSource creado (el store siempre es el del vault).
+func NewTokenVault(clientID, clientSecret string, store TokenStore, opts ...TokenSourceOption) *TokenVault {
+	return &TokenVault{
+		clientID:     clientID,
+		clientSecret: clientSecret,
+		store:        store,
+		opts:         opts,
+		sources:      make(map[int64]*RefreshingTokenSource),
+	}
+}
+
+// Add guarda el token de un vendedor (ej. el obtenido con ExchangeCode) y lo entrega a su fuente si ya existe.
+func (v *TokenVault) Add(ctx context.Context, token Token) error {
+	if err := v.store.Save(ctx, token); err != nil {
+		return err
+	}
+	v.mu.Lock()
+	source, ok := v.sources[token.UserID]
+	v.mu.Unlock()
+	if !ok {
+		return nil
+	}
+	return source.set(ctx, token)
+}
+
+// TokenSource devuelve la fuente de tokens del vendedor userID. El token se carga del store en el primer uso.
+func (v *TokenVault) TokenSource(userID int64) *RefreshingTokenSource {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	source, ok := v.sources[userID]
+	if !ok {
+		opts := append(append([]TokenSourceOption{}, v.opts...), WithTokenStore(v.store))
+		source = NewRefreshingTokenSource(v.clientID, v.clientSecret, Token{UserID: userID}, opts...)
+		v.sources[userID] = source
+	}
+	return source
+}
+
+// Client crea un Client que se autentica como el vendedor userID.
+func (v *TokenVault) Client(userID int64, opts ...Option) *Client {
+	opts = append(append([]Option{}, opts...), WithTokenSource(v.TokenSource(userID)))
+	return NewClient(opts...)
+}
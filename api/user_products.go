@@ -2,15 +2,14 @@ package api
 
 import (
 	"context"
-	"fmt"
 	"time"
 
 	"github.com/tidyrocks/mercado-libre-go-sdk/internal/http"
 )
 
-const userProductsEndpoint = "https://api.mercadolibre.com/user-products"
-const siteUserProductFamiliesEndpoint = "https://api.mercadolibre.com/sites"
-const itemsEligibilityEndpoint = "https://api.mercadolibre.com/items"
+const userProductsEndpoint = "/user-products"
+const siteUserProductFamiliesEndpoint = "/sites"
+const itemsEligibilityEndpoint = "/items"
 
 // UserProduct representa un producto físico que un vendedor posee en el nuevo modelo de User Products
 type UserProduct struct {
@@ -88,33 +87,53 @@ type UserProductMigrationRequest struct {
 }
 
 // GetUserProductByID obtiene información detallada de un User Product por su ID
-func GetUserProductByID(ctx context.Context, userProductID, accessToken string) (UserProduct, error) {
-	url := fmt.Sprintf("%s/%s", userProductsEndpoint, userProductID)
+func (c *Client) GetUserProductByID(ctx context.Context, userProductID, accessToken string) (UserProduct, error) {
+	url := c.endpoint("%s/%s", userProductsEndpoint, userProductID)
 	var userProduct UserProduct
-	err := http.DoGetJSON(ctx, url, accessToken, &userProduct)
+	err := http.DoGetJSON(ctx, c, url, accessToken, &userProduct)
 	return userProduct, err
 }
 
+// GetUserProductByID obtiene un User Product usando el Client por defecto
+func GetUserProductByID(ctx context.Context, userProductID, accessToken string) (UserProduct, error) {
+	return defaultClient.GetUserProductByID(ctx, userProductID, accessToken)
+}
+
 // GetUserProductFamilyByID obtiene todos los User Products de una familia específica
-func GetUserProductFamilyByID(ctx context.Context, siteID, familyID, accessToken string) (UserProductFamily, error) {
-	url := fmt.Sprintf("%s/%s/user-products-families/%s", siteUserProductFamiliesEndpoint, siteID, familyID)
+func (c *Client) GetUserProductFamilyByID(ctx context.Context, siteID, familyID, accessToken string) (UserProductFamily, error) {
+	url := c.endpoint("%s/%s/user-products-families/%s", siteUserProductFamiliesEndpoint, siteID, familyID)
 	var family UserProductFamily
-	err := http.DoGetJSON(ctx, url, accessToken, &family)
+	err := http.DoGetJSON(ctx, c, url, accessToken, &family)
 	return family, err
 }
 
+// GetUserProductFamilyByID obtiene una familia de User Products usando el Client por defecto
+func GetUserProductFamilyByID(ctx context.Context, siteID, familyID, accessToken string) (UserProductFamily, error) {
+	return defaultClient.GetUserProductFamilyByID(ctx, siteID, familyID, accessToken)
+}
+
 // GetUserProductStock obtiene información del stock de un User Product
-func GetUserProductStock(ctx context.Context, userProductID, accessToken string) (UserProductStock, error) {
-	url := fmt.Sprintf("%s/%s/stock", userProductsEndpoint, userProductID)
+func (c *Client) GetUserProductStock(ctx context.Context, userProductID, accessToken string) (UserProductStock, error) {
+	url := c.endpoint("%s/%s/stock", userProductsEndpoint, userProductID)
 	var stock UserProductStock
-	err := http.DoGetJSON(ctx, url, accessToken, &stock)
+	err := http.DoGetJSON(ctx, c, url, accessToken, &stock)
 	return stock, err
 }
 
+// GetUserProductStock obtiene el stock de un User Product usando el Client por defecto
+func GetUserProductStock(ctx context.Context, userProductID, accessToken string) (UserProductStock, error) {
+	return defaultClient.GetUserProductStock(ctx, userProductID, accessToken)
+}
+
 // ValidateItemEligibility valida si un ítem es elegible para migración al modelo User Products
-func ValidateItemEligibility(ctx context.Context, itemID, accessToken string) (UserProductEligibility, error) {
-	url := fmt.Sprintf("%s/%s/user_product_listings/validate", itemsEligibilityEndpoint, itemID)
+func (c *Client) ValidateItemEligibility(ctx context.Context, itemID, accessToken string) (UserProductEligibility, error) {
+	url := c.endpoint("%s/%s/user_product_listings/validate", itemsEligibilityEndpoint, itemID)
 	var eligibility UserProductEligibility
-	err := http.DoGetJSON(ctx, url, accessToken, &eligibility)
+	err := http.DoGetJSON(ctx, c, url, accessToken, &eligibility)
 	return eligibility, err
-}
\ No newline at end of file
+}
+
+// ValidateItemEligibility valida la elegibilidad de migración usando el Client por defecto
+func ValidateItemEligibility(ctx context.Context, itemID, accessToken string) (UserProductEligibility, error) {
+	return defaultClient.ValidateItemEligibility(ctx, itemID, accessToken)
+}
@@ -0,0 +1,246 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	nethttp "net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/tidyrocks/mercado-libre-go-sdk/internal/http"
+)
+
+// ViolationCode identifica el tipo de regla incumplida por un ítem
+type ViolationCode string
+
+const (
+	ViolationMissingRequired   ViolationCode = "missing_required"       // Falta un atributo requerido
+	ViolationUnknownAttr       ViolationCode = "unknown_attribute"      // El atributo no existe en la categoría
+	ViolationInvalidNumber     ViolationCode = "invalid_number"         // Valor no numérico en number o number_unit
+	ViolationInvalidUnit       ViolationCode = "invalid_unit"           // Unidad ausente o no permitida
+	ViolationInvalidValue      ViolationCode = "invalid_value"          // Valor fuera de los permitidos (list, boolean)
+	ViolationValueTooLong      ViolationCode = "value_too_long"         // Valor más largo que ValueMaxLength
+	ViolationNotListable       ViolationCode = "category_not_listable"  // La categoría no admite publicaciones
+	ViolationTitleTooLong      ViolationCode = "title_too_long"         // Título más largo que MaxTitle
+	ViolationTooManyPictures   ViolationCode = "too_many_pictures"      // Más imágenes que MaxPicturesPerItem
+	ViolationVariationsAllowed ViolationCode = "variations_not_allowed" // Variaciones en una categoría que no las admite
+	ViolationCondition         ViolationCode = "invalid_condition"      // Condición fuera de ItemConditions
+	ViolationBuyingMode        ViolationCode = "invalid_buying_mode"    // Modo de compra fuera de BuyingModes
+	ViolationCurrency          ViolationCode = "invalid_currency"       // Moneda fuera de CurrenciesAllowed
+)
+
+// notApplicableValueID es el value_id con el que MELI marca un atributo como "no aplica".
+const notApplicableValueID = "-1"
+
+// ItemViolation representa una regla de la categoría que el ítem no cumple
+type ItemViolation struct {
+	Code    ViolationCode // Tipo de violación
+	AttrID  string        // ID del atributo (vacío si la regla es de la categoría)
+	Field   string        // Campo del ítem afectado (ej. "title", "attributes")
+	Message string        // Descripción
+}
+
+// Error implementa la interfaz error.
+func (v ItemViolation) Error() string {
+	if v.AttrID != "" {
+		return fmt.Sprintf("%s: %s: %s", v.Code, v.AttrID, v.Message)
+	}
+	return fmt.Sprintf("%s: %s", v.Code, v.Message)
+}
+
+// ValidateItemAttrs verifica los atributos de un ítem contra GetCategoryAttributes: requeridos,
+// ValueType, ValueMaxLength, AllowedUnits y valores permitidos. En los atributos list y boolean
+// un value_name sin value_id debe coincidir (sin distinguir mayúsculas) con el nombre de algún valor
+// permitido; los list sin valores definidos aceptan cualquier value_name. Los atributos con
+// value_id "-1" ("no aplica") cuentan como cargados y no se verifica su tipo.
+func ValidateItemAttrs(attrs []Attr, categoryAttrs []Attr) []ItemViolation {
+	var violations []ItemViolation
+	add := func(code ViolationCode, attrID, format string, args ...any) {
+		violations = append(violations, ItemViolation{Code: code, AttrID: attrID, Field: "attributes", Message: fmt.Sprintf(format, args...)})
+	}
+
+	definitions := make(map[string]Attr, len(categoryAttrs))
+	for _, definition := range categoryAttrs {
+		definitions[definition.ID] = definition
+	}
+
+	present := make(map[string]bool, len(attrs))
+	for _, attr := range attrs {
+		definition, ok := definitions[attr.ID]
+		if !ok {
+			add(ViolationUnknownAttr, attr.ID, "no existe en la categoría")
+			continue
+		}
+		if !hasAttrValue(attr) {
+			continue
+		}
+		present[attr.ID] = true
+		if attr.ValueID != nil && *attr.ValueID == notApplicableValueID {
+			continue
+		}
+
+		if definition.ValueMaxLength != nil && utf8.RuneCountInString(attr.ValueName) > *definition.ValueMaxLength {
+			add(ViolationValueTooLong, attr.ID, "%d caracteres, máximo %d", utf8.RuneCountInString(attr.ValueName), *definition.ValueMaxLength)
+		}
+
+		switch definition.ValueType {
+		case "number":
+			if _, err := strconv.ParseFloat(strings.TrimSpace(attr.ValueName), 64); err != nil {
+				add(ViolationInvalidNumber, attr.ID, "%q no es un número", attr.ValueName)
+			}
+		case "number_unit":
+			number, unit, ok := attrNumberUnit(attr)
+			switch {
+			case !ok:
+				add(ViolationInvalidNumber, attr.ID, "%q no tiene el formato \"número unidad\"", attr.ValueName)
+			case unit == "":
+				add(ViolationInvalidUnit, attr.ID, "%v sin unidad", number)
+			case len(definition.AllowedUnits) > 0 && !slices.ContainsFunc(definition.AllowedUnits, func(u UnitOfMeasurement) bool { return u.ID == unit }):
+				add(ViolationInvalidUnit, attr.ID, "unidad %q no permitida", unit)
+			}
+		case "list", "boolean":
+			valueID, valueName := selectedValue(attr)
+			switch {
+			case valueID != nil:
+				if len(definition.Values) > 0 && !slices.ContainsFunc(definition.Values, func(v AttrVal) bool { return v.ID != nil && *v.ID == *valueID }) {
+					add(ViolationInvalidValue, attr.ID, "value_id %q no permitido", *valueID)
+				}
+			case valueName == "": // Sin value_id ni value_name que verificar
+			case definition.ValueType == "boolean" && !hasValueNamed(definition.Values, valueName):
+				add(ViolationInvalidValue, attr.ID, "%q no es un valor booleano permitido", valueName)
+			case definition.ValueType == "list" && len(definition.Values) > 0 && !hasValueNamed(definition.Values, valueName):
+				add(ViolationInvalidValue, attr.ID, "value_name %q no permitido", valueName)
+			}
+		}
+	}
+
+	for _, definition := range categoryAttrs {
+		if definition.Tags["required"] && !definition.Tags["read_only"] && !present[definition.ID] {
+			add(ViolationMissingRequired, definition.ID, "atributo requerido sin valor")
+		}
+	}
+	return violations
+}
+
+// selectedValue devuelve el value_id y value_name del atributo o, si no están, los del primer elemento de values.
+func selectedValue(attr Attr) (*string, string) {
+	if attr.ValueID != nil || attr.ValueName != "" || len(attr.Values) == 0 {
+		return attr.ValueID, attr.ValueName
+	}
+	name := ""
+	if attr.Values[0].Name != nil {
+		name = *attr.Values[0].Name
+	}
+	return attr.Values[0].ID, name
+}
+
+// hasValueNamed indica si values contiene un valor llamado name, sin distinguir mayúsculas.
+func hasValueNamed(values []AttrVal, name string) bool {
+	return slices.ContainsFunc(values, func(v AttrVal) bool {
+		return v.Name != nil && strings.EqualFold(*v.Name, name)
+	})
+}
+
+// hasAttrValue indica si el atributo tiene algún valor cargado.
+func hasAttrValue(attr Attr) bool {
+	return attr.ValueName != "" || attr.ValueID != nil || attr.MeasuredValue != nil || len(attr.Values) > 0
+}
+
+// attrNumberUnit obtiene número y unidad de value_struct o, si no está, de value_name (ej. "10 cm").
+func attrNumberUnit(attr Attr) (float64, string, bool) {
+	if attr.MeasuredValue != nil && attr.MeasuredValue.Number != nil {
+		unit := ""
+		if attr.MeasuredValue.Unit != nil {
+			unit = *attr.MeasuredValue.Unit
+		}
+		return *attr.MeasuredValue.Number, unit, true
+	}
+	number, unit, _ := strings.Cut(strings.TrimSpace(attr.ValueName), " ")
+	value, err := strconv.ParseFloat(number, 64)
+	if err != nil {
+		return 0, "", false
+	}
+	return value, strings.TrimSpace(unit), true
+}
+
+// ValidateItemSettings verifica el ítem contra los límites de CategorySettings.
+// Los límites numéricos en cero y las listas vacías no se verifican.
+func ValidateItemSettings(item Item, settings CategorySettings) []ItemViolation {
+	var violations []ItemViolation
+	add := func(code ViolationCode, field, format string, args ...any) {
+		violations = append(violations, ItemViolation{Code: code, Field: field, Message: fmt.Sprintf(format, args...)})
+	}
+
+	if !settings.ListingAllowed {
+		add(ViolationNotListable, "category_id", "la categoría no admite publicaciones (no es hoja)")
+	}
+	if length := utf8.RuneCountInString(item.Title); settings.MaxTitle > 0 && length > settings.MaxTitle {
+		add(ViolationTitleTooLong, "title", "%d caracteres, máximo %d", length, settings.MaxTitle)
+	}
+	if settings.MaxPicturesPerItem > 0 && len(item.Pictures) > settings.MaxPicturesPerItem {
+		add(ViolationTooManyPictures, "pictures", "%d imágenes, máximo %d", len(item.Pictures), settings.MaxPicturesPerItem)
+	}
+	if !settings.VariationsAllowed && len(item.Variations) > 0 {
+		add(ViolationVariationsAllowed, "variations", "la categoría no admite variaciones")
+	}
+	if len(settings.ItemConditions) > 0 && !slices.Contains(settings.ItemConditions, item.Condition) {
+		add(ViolationCondition, "condition", "%q no está entre %v", item.Condition, settings.ItemConditions)
+	}
+	if len(settings.BuyingModes) > 0 && !slices.Contains(settings.BuyingModes, item.BuyingMode) {
+		add(ViolationBuyingMode, "buying_mode", "%q no está entre %v", item.BuyingMode, settings.BuyingModes)
+	}
+	if len(settings.CurrenciesAllowed) > 0 && !slices.Contains(settings.CurrenciesAllowed, item.CurrencyID) {
+		add(ViolationCurrency, "currency_id", "%q no está entre %v", item.CurrencyID, settings.CurrenciesAllowed)
+	}
+	return violations
+}
+
+// ValidateItemRules verifica localmente el ítem contra la configuración y los atributos de su categoría.
+func ValidateItemRules(item Item, category Category, categoryAttrs []Attr) []ItemViolation {
+	violations := ValidateItemSettings(item, category.Settings)
+	return append(violations, ValidateItemAttrs(item.Attrs, categoryAttrs)...)
+}
+
+// CheckItem obtiene la categoría del ítem y sus atributos y lo verifica con ValidateItemRules.
+func (c *Client) CheckItem(ctx context.Context, item Item, accessToken string) ([]ItemViolation, error) {
+	category, err := c.GetCategoryByID(ctx, item.CategoryID, accessToken)
+	if err != nil {
+		return nil, err
+	}
+	categoryAttrs, err := c.GetCategoryAttributes(ctx, item.CategoryID, accessToken)
+	if err != nil {
+		return nil, err
+	}
+	return ValidateItemRules(item, category, categoryAttrs), nil
+}
+
+// CheckItem verifica localmente un ítem usando el Client por defecto
+func CheckItem(ctx context.Context, item Item, accessToken string) ([]ItemViolation, error) {
+	return defaultClient.CheckItem(ctx, item, accessToken)
+}
+
+// ValidateItem valida request en el servidor (POST /items/validate) sin publicarlo.
+// Devuelve nil si el ítem es válido (204) y las causas si la API lo rechaza con 400;
+// cualquier otro fallo (token, red, 5xx) se devuelve como error.
+func (c *Client) ValidateItem(ctx context.Context, request ItemRequest, accessToken string) ([]ErrorCause, error) {
+	url := c.endpoint("%s/validate", itemsEndpoint)
+	var response struct{}
+	err := http.DoPostJSON(ctx, c, url, accessToken, request, &response)
+
+	var apiErr *Error
+	if errors.As(err, &apiErr) && apiErr.StatusCode == nethttp.StatusBadRequest {
+		if len(apiErr.Cause) == 0 {
+			return []ErrorCause{{Code: apiErr.Code, Message: apiErr.Message}}, nil
+		}
+		return apiErr.Cause, nil
+	}
+	return nil, err
+}
+
+// ValidateItem valida un ítem en el servidor usando el Client por defecto
+func ValidateItem(ctx context.Context, request ItemRequest, accessToken string) ([]ErrorCause, error) {
+	return defaultClient.ValidateItem(ctx, request, accessToken)
+}
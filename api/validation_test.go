@@ -0,0 +1,68 @@
+package api
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestValidateItemAttrsListValueName(t *testing.T) {
+	id, red, blue := "52049", "Rojo", "Azul"
+	categoryAttrs := []Attr{
+		{ID: "COLOR", ValueType: "list", Values: []AttrVal{{ID: &id, Name: &red}, {Name: &blue}}},
+		{ID: "MODEL", ValueType: "list"}, // Sin valores definidos: acepta cualquier value_name
+	}
+
+	tests := []struct {
+		name  string
+		attrs []Attr
+		want  []ViolationCode
+	}{
+		{"value_id permitido", []Attr{{ID: "COLOR", ValueID: &id}}, nil},
+		{"value_name permitido", []Attr{{ID: "COLOR", ValueName: "rojo"}}, nil},
+		{"value_name de valor sin ID", []Attr{{ID: "COLOR", ValueName: "AZUL"}}, nil},
+		{"value_name no permitido", []Attr{{ID: "COLOR", ValueName: "Verde"}}, []ViolationCode{ViolationInvalidValue}},
+		{"list sin valores definidos", []Attr{{ID: "MODEL", ValueName: "X200"}}, nil},
+	}
+	for _, tt := range tests {
+		var got []ViolationCode
+		for _, violation := range ValidateItemAttrs(tt.attrs, categoryAttrs) {
+			got = append(got, violation.Code)
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("%s: violaciones = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestValidateItemAttrsValues(t *testing.T) {
+	yesID, yes, notApplicable := "242085", "Sí", "-1"
+	maxLength := 3
+	categoryAttrs := []Attr{
+		{ID: "WEIGHT", ValueType: "number_unit", AllowedUnits: []UnitOfMeasurement{{ID: "kg"}}, Tags: map[string]bool{"required": true}},
+		{ID: "PIECES", ValueType: "number", ValueMaxLength: &maxLength},
+		{ID: "IS_KIT", ValueType: "boolean", Values: []AttrVal{{ID: &yesID, Name: &yes}}},
+	}
+
+	tests := []struct {
+		name  string
+		attrs []Attr
+		want  []ViolationCode
+	}{
+		{"number_unit no aplica", []Attr{{ID: "WEIGHT", ValueID: &notApplicable, ValueName: "N/A"}}, nil},
+		{"number no aplica", []Attr{{ID: "WEIGHT", ValueName: "2 kg"}, {ID: "PIECES", ValueID: &notApplicable, ValueName: "No aplica"}}, nil},
+		{"number inválido", []Attr{{ID: "WEIGHT", ValueName: "2 kg"}, {ID: "PIECES", ValueName: "dos"}}, []ViolationCode{ViolationInvalidNumber}},
+		{"boolean solo en values", []Attr{{ID: "WEIGHT", ValueName: "2 kg"}, {ID: "IS_KIT", Values: []AttrVal{{Name: &yes}}}}, nil},
+		{"boolean solo value_id en values", []Attr{{ID: "WEIGHT", ValueName: "2 kg"}, {ID: "IS_KIT", Values: []AttrVal{{ID: &yesID}}}}, nil},
+		{"boolean no permitido", []Attr{{ID: "WEIGHT", ValueName: "2 kg"}, {ID: "IS_KIT", ValueName: "Tal vez"}}, []ViolationCode{ViolationInvalidValue}},
+		{"requerido sin valor", nil, []ViolationCode{ViolationMissingRequired}},
+	}
+	for _, tt := range tests {
+		var got []ViolationCode
+		for _, violation := range ValidateItemAttrs(tt.attrs, categoryAttrs) {
+			got = append(got, violation.Code)
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("%s: violaciones = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
@@ -17,3 +17,52 @@ type Variation struct {
 	// field > ItemRelations
 	UserProductID *string `json:"user_product_id,omitempty"`
 }
+
+// FindVariation busca una variación del ítem por su ID
+func (i Item) FindVariation(variationID int64) (Variation, bool) {
+	for _, variation := range i.Variations {
+		if variation.ID == variationID {
+			return variation, true
+		}
+	}
+	return Variation{}, false
+}
+
+// VariationRequest representa una variación enviada al crear o actualizar un ítem
+// ID vacío crea una variación nueva; con ID se actualiza la existente
+type VariationRequest struct {
+	ID                *int64        `json:"id,omitempty"`
+	Price             *float64      `json:"price,omitempty"`
+	AttrCombinations  []AttrRequest `json:"attribute_combinations,omitempty"`
+	AvailableQuantity *int          `json:"available_quantity,omitempty"`
+	PictureIDs        []string      `json:"picture_ids,omitempty"`
+	Attrs             []AttrRequest `json:"attributes,omitempty"`
+	SellerCustomField *string       `json:"seller_custom_field,omitempty"`
+}
+
+// NewVariationRequest convierte una variación leída de la API en una variación para escritura
+func NewVariationRequest(variation Variation) VariationRequest {
+	request := VariationRequest{
+		Price:             &variation.Price,
+		AttrCombinations:  NewAttrRequests(variation.AttrCombinations),
+		AvailableQuantity: &variation.AvailableQuantity,
+		PictureIDs:        variation.PictureIDs,
+		SellerCustomField: variation.SellerCustomField,
+	}
+	if variation.ID != 0 {
+		request.ID = &variation.ID
+	}
+	return request
+}
+
+// NewVariationRequests convierte una lista de variaciones
+func NewVariationRequests(variations []Variation) []VariationRequest {
+	if variations == nil {
+		return nil
+	}
+	requests := make([]VariationRequest, 0, len(variations))
+	for _, variation := range variations {
+		requests = append(requests, NewVariationRequest(variation))
+	}
+	return requests
+}
@@ -5,41 +5,80 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"mime/multipart"
 	"net/http"
 	"net/url"
 )
 
-// DoGetJSON agrega Bearer token si se provee y decodifica respuesta.
-func DoGetJSON[T any](ctx context.Context, url, token string, target *T) error {
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
-	if err != nil {
-		return err
+// Doer ejecuta una petición HTTP. *http.Client y el Client del paquete api lo implementan.
+type Doer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// send ejecuta req con doer (o http.DefaultClient si es nil) y valida el status.
+// El llamador debe cerrar el cuerpo de la respuesta.
+func send(doer Doer, req *http.Request, token string) (*http.Response, error) {
+	if doer == nil {
+		doer = http.DefaultClient
 	}
 	if token != "" {
 		req.Header.Set("Authorization", "Bearer "+token)
 	}
-	req.Header.Set("Accept", "application/json")
+	if req.Header.Get("Accept") == "" {
+		req.Header.Set("Accept", "application/json")
+	}
+
+	resp, err := doer.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		resp.Body.Close()
+		return nil, fmt.Errorf("status inesperado: %d", resp.StatusCode)
+	}
+	return resp, nil
+}
 
-	resp, err := http.DefaultClient.Do(req)
+// do ejecuta req y decodifica la respuesta en target.
+func do[T any](doer Doer, req *http.Request, token string, target *T) error {
+	resp, err := send(doer, req, token)
 	if err != nil {
 		return err
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("status inesperado: %d", resp.StatusCode)
+	// Un cuerpo vacío (ej. 204 o 201 sin contenido) deja target sin cambios
+	if err := json.NewDecoder(resp.Body).Decode(target); err != nil && err != io.EOF {
+		return err
 	}
+	return nil
+}
 
-	return json.NewDecoder(resp.Body).Decode(target)
+// DoGetJSON agrega Bearer token si se provee y decodifica respuesta.
+func DoGetJSON[T any](ctx context.Context, doer Doer, url, token string, target *T) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	return do(doer, req, token, target)
 }
 
 // DoGetJSONWithParams hace GET con query parameters, token opcional y decodifica en target.
-func DoGetJSONWithParams[T any](ctx context.Context, baseURL, token string, params url.Values, target *T) error {
-	u, err := url.Parse(baseURL)
+func DoGetJSONWithParams[T any](ctx context.Context, doer Doer, baseURL, token string, params url.Values, target *T) error {
+	u, err := WithParams(baseURL, params)
 	if err != nil {
 		return err
 	}
+	return DoGetJSON(ctx, doer, u, token, target)
+}
+
+// WithParams agrega params a la query de baseURL.
+func WithParams(baseURL string, params url.Values) (string, error) {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return "", err
+	}
 
 	if len(params) > 0 {
 		query := u.Query()
@@ -50,114 +89,96 @@ func DoGetJSONWithParams[T any](ctx context.Context, baseURL, token string, para
 		}
 		u.RawQuery = query.Encode()
 	}
-
-	return DoGetJSON(ctx, u.String(), token, target)
+	return u.String(), nil
 }
 
 // DoPostJSON hace POST con JSON body, token opcional y decodifica en target.
-func DoPostJSON[T any](ctx context.Context, url, token string, body interface{}, target *T) error {
-	jsonBody, err := json.Marshal(body)
-	if err != nil {
-		return err
-	}
-
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewBuffer(jsonBody))
-	if err != nil {
-		return err
-	}
-
-	if token != "" {
-		req.Header.Set("Authorization", "Bearer "+token)
-	}
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Accept", "application/json")
-
-	resp, err := http.DefaultClient.Do(req)
-	if err != nil {
-		return err
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return fmt.Errorf("status inesperado: %d", resp.StatusCode)
-	}
-
-	return json.NewDecoder(resp.Body).Decode(target)
+func DoPostJSON[T any](ctx context.Context, doer Doer, url, token string, body interface{}, target *T) error {
+	return doJSONBody(ctx, doer, http.MethodPost, url, token, body, target)
 }
 
-// DoMultipartUpload hace POST multipart/form-data para subir archivos.
-func DoMultipartUpload[T any](ctx context.Context, url, token string, fileContent []byte, filename string, target *T) error {
-	var buffer bytes.Buffer
-	writer := multipart.NewWriter(&buffer)
-
-	// Crear el campo file
-	part, err := writer.CreateFormFile("file", filename)
-	if err != nil {
-		return err
-	}
-
-	// Escribir el contenido del archivo
-	_, err = part.Write(fileContent)
-	if err != nil {
-		return err
-	}
+// DoPutJSON hace PUT con JSON body, token opcional y decodifica en target.
+func DoPutJSON[T any](ctx context.Context, doer Doer, url, token string, body interface{}, target *T) error {
+	return doJSONBody(ctx, doer, http.MethodPut, url, token, body, target)
+}
 
-	// Cerrar el writer para finalizar el boundary
-	err = writer.Close()
+// doJSONBody serializa body como JSON y lo envía con el método indicado.
+func doJSONBody[T any](ctx context.Context, doer Doer, method, url, token string, body interface{}, target *T) error {
+	jsonBody, err := json.Marshal(body)
 	if err != nil {
 		return err
 	}
+	return DoReaderJSON(ctx, doer, method, url, token, "application/json", bytes.NewReader(jsonBody), target)
+}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, &buffer)
+// DoDelete hace DELETE con token opcional y descarta el cuerpo de la respuesta.
+func DoDelete(ctx context.Context, doer Doer, url, token string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, url, nil)
 	if err != nil {
 		return err
 	}
-
-	if token != "" {
-		req.Header.Set("Authorization", "Bearer "+token)
-	}
-	req.Header.Set("Content-Type", writer.FormDataContentType())
-
-	resp, err := http.DefaultClient.Do(req)
+	resp, err := send(doer, req, token)
 	if err != nil {
 		return err
 	}
 	defer resp.Body.Close()
-
-	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return fmt.Errorf("status inesperado: %d", resp.StatusCode)
-	}
-
-	return json.NewDecoder(resp.Body).Decode(target)
+	_, err = io.Copy(io.Discard, resp.Body)
+	return err
 }
 
-// DoPutJSON hace PUT con JSON body, token opcional y decodifica en target.
-func DoPutJSON[T any](ctx context.Context, url, token string, body interface{}, target *T) error {
-	jsonBody, err := json.Marshal(body)
+// DoReaderJSON envía body tal cual con el content type indicado y decodifica la respuesta en target.
+// Si body no es *bytes.Reader, *bytes.Buffer o *strings.Reader la petición no se puede reintentar.
+func DoReaderJSON[T any](ctx context.Context, doer Doer, method, url, token, contentType string, body io.Reader, target *T) error {
+	req, err := http.NewRequestWithContext(ctx, method, url, body)
 	if err != nil {
 		return err
 	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	return do(doer, req, token, target)
+}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPut, url, bytes.NewBuffer(jsonBody))
+// DoStream ejecuta la petición y devuelve el cuerpo de la respuesta sin decodificar.
+// body puede ser nil. El llamador debe cerrar el io.ReadCloser devuelto.
+func DoStream(ctx context.Context, doer Doer, method, url, token, contentType string, body io.Reader) (io.ReadCloser, error) {
+	req, err := http.NewRequestWithContext(ctx, method, url, body)
 	if err != nil {
-		return err
+		return nil, err
 	}
-
-	if token != "" {
-		req.Header.Set("Authorization", "Bearer "+token)
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
 	}
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Accept", "*/*")
 
-	resp, err := http.DefaultClient.Do(req)
+	resp, err := send(doer, req, token)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	defer resp.Body.Close()
+	return resp.Body, nil
+}
 
-	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return fmt.Errorf("status inesperado: %d", resp.StatusCode)
-	}
+// DoMultipartUpload hace POST multipart/form-data para subir archivos.
+// El archivo se copia a la petición a través de un io.Pipe, sin cargarlo completo en memoria.
+func DoMultipartUpload[T any](ctx context.Context, doer Doer, url, token string, file io.Reader, filename string, target *T) error {
+	pipeReader, pipeWriter := io.Pipe()
+	writer := multipart.NewWriter(pipeWriter)
+
+	go func() {
+		// Crear el campo file y copiar el contenido; un error se propaga al lector del pipe
+		part, err := writer.CreateFormFile("file", filename)
+		if err == nil {
+			_, err = io.Copy(part, file)
+		}
+		if err == nil {
+			// Cerrar el writer para finalizar el boundary
+			err = writer.Close()
+		}
+		pipeWriter.CloseWithError(err)
+	}()
 
-	return json.NewDecoder(resp.Body).Decode(target)
-}
\ No newline at end of file
+	err := DoReaderJSON(ctx, doer, http.MethodPost, url, token, writer.FormDataContentType(), pipeReader, target)
+	// Desbloquea la goroutine si la petición terminó antes de consumir todo el archivo
+	pipeReader.Close()
+	return err
+}
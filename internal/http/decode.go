@@ -0,0 +1,70 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// DecodeArray recorre un arreglo JSON de r elemento por elemento, sin cargarlo completo en memoria.
+// path indica el campo que contiene el arreglo ("" para un arreglo en la raíz, "a.b" para campos anidados).
+// Si fn devuelve error, la lectura se detiene y se devuelve ese error.
+func DecodeArray[T any](r io.Reader, path string, fn func(T) error) error {
+	dec := json.NewDecoder(r)
+	if path != "" {
+		for _, field := range strings.Split(path, ".") {
+			if err := seekField(dec, field); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := expectDelim(dec, '['); err != nil {
+		return err
+	}
+	for dec.More() {
+		var element T
+		if err := dec.Decode(&element); err != nil {
+			return err
+		}
+		if err := fn(element); err != nil {
+			return err
+		}
+	}
+	return expectDelim(dec, ']')
+}
+
+// seekField avanza dec hasta el valor del campo field del objeto actual.
+func seekField(dec *json.Decoder, field string) error {
+	if err := expectDelim(dec, '{'); err != nil {
+		return err
+	}
+	for dec.More() {
+		token, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		if key, _ := token.(string); key == field {
+			return nil
+		}
+		// Saltar el valor de otro campo
+		var skip json.RawMessage
+		if err := dec.Decode(&skip); err != nil {
+			return err
+		}
+	}
+	return fmt.Errorf("campo %q no encontrado", field)
+}
+
+// expectDelim lee el siguiente token y verifica que sea el delimitador indicado.
+func expectDelim(dec *json.Decoder, delim json.Delim) error {
+	token, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if d, ok := token.(json.Delim); !ok || d != delim {
+		return fmt.Errorf("se esperaba %q y se encontró %v", delim, token)
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+package http
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestDecodeArrayNestedPath(t *testing.T) {
+	// Los campos previos tienen arreglos y objetos (con claves iguales a las buscadas) que deben saltarse
+	body := `{
+		"paging": {"total": 3, "results": [9, 9]},
+		"results": [[0], {"results": [8]}],
+		"data": {
+			"meta": [{"id": 7}],
+			"results": [{"id": 1}, {"id": 2}, {"id": 3}],
+			"after": true
+		}
+	}`
+
+	var ids []int
+	err := DecodeArray(strings.NewReader(body), "data.results", func(e struct{ ID int }) error {
+		ids = append(ids, e.ID)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("DecodeArray: %v", err)
+	}
+	if !slices.Equal(ids, []int{1, 2, 3}) {
+		t.Errorf("ids = %v, want [1 2 3]", ids)
+	}
+}
+
+func TestDecodeArrayRoot(t *testing.T) {
+	var names []string
+	err := DecodeArray(strings.NewReader(`["a","b"]`), "", func(name string) error {
+		names = append(names, name)
+		return nil
+	})
+	if err != nil || !slices.Equal(names, []string{"a", "b"}) {
+		t.Errorf("names = %v, err = %v", names, err)
+	}
+}
+
+func TestDecodeArrayErrors(t *testing.T) {
+	if err := DecodeArray(strings.NewReader(`{"other":[1]}`), "results", func(int) error { return nil }); err == nil {
+		t.Error("campo inexistente: err nil")
+	}
+	if err := DecodeArray(strings.NewReader(`{"results":{"id":1}}`), "results", func(int) error { return nil }); err == nil {
+		t.Error("campo que no es arreglo: err nil")
+	}
+
+	stop := errors.New("stop")
+	calls := 0
+	err := DecodeArray(strings.NewReader(`[1,2,3]`), "", func(int) error {
+		calls++
+		return stop
+	})
+	if err != stop || calls != 1 {
+		t.Errorf("err = %v, calls = %d; want stop tras 1 elemento", err, calls)
+	}
+}